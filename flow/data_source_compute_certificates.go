@@ -0,0 +1,115 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeCertificatesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeCertificatesDataSource)(nil)
+)
+
+type computeCertificatesDataSourceData struct {
+	ID           types.String                       `tfsdk:"id"`
+	Name         types.String                       `tfsdk:"name"`
+	LocationID   types.Int64                        `tfsdk:"location_id"`
+	Certificates []computeCertificateDataSourceData `tfsdk:"certificates"`
+}
+
+func (c computeCertificatesDataSourceData) AppliesTo(certificate compute.Certificate) bool {
+	return computeCertificateDataSourceData{
+		ID:         types.Int64{Null: true},
+		Name:       c.Name,
+		LocationID: c.LocationID,
+	}.AppliesTo(certificate)
+}
+
+type computeCertificatesDataSourceType struct{}
+
+func (c computeCertificatesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	certificateSchema, diagnostics := computeCertificateDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	// the singular schema also declares the certificate and private key of the resource, which the api never returns
+	certificateAttributes := listDataSourceAttributes(certificateSchema.Attributes)
+	delete(certificateAttributes, "certificate")
+	delete(certificateAttributes, "private_key")
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching certificates",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the certificate",
+				Optional:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the location",
+				Optional:            true,
+			},
+			"certificates": {
+				Attributes:          tfsdk.ListNestedAttributes(certificateAttributes),
+				MarkdownDescription: "list of certificates matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeCertificatesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeCertificatesDataSource{
+		certificateService: compute.NewCertificateService(prov.client),
+	}, diagnostics
+}
+
+type computeCertificatesDataSource struct {
+	certificateService compute.CertificateService
+}
+
+func (c computeCertificatesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeCertificatesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.certificateService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list certificates: %s", err))
+		return
+	}
+
+	certificates := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(certificates, func(certificate compute.Certificate) int { return certificate.ID })
+	state.Certificates = make([]computeCertificateDataSourceData, len(certificates))
+	for idx, certificate := range certificates {
+		state.Certificates[idx].FromEntity(certificate)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
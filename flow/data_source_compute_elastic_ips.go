@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeElasticIPsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeElasticIPsDataSource)(nil)
+)
+
+type computeElasticIPsDataSourceData struct {
+	ID         types.String                     `tfsdk:"id"`
+	LocationID types.Int64                      `tfsdk:"location_id"`
+	PublicIP   types.String                     `tfsdk:"public_ip"`
+	ElasticIPs []computeElasticIPDataSourceData `tfsdk:"elastic_ips"`
+}
+
+func (c computeElasticIPsDataSourceData) AppliesTo(elasticIP compute.ElasticIP) bool {
+	return computeElasticIPDataSourceData{
+		ID:         types.Int64{Null: true},
+		LocationID: c.LocationID,
+		PublicIP:   c.PublicIP,
+	}.AppliesTo(elasticIP)
+}
+
+type computeElasticIPsDataSourceType struct{}
+
+func (c computeElasticIPsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	elasticIPSchema, diagnostics := computeElasticIPDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching elastic ips",
+				Computed:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "location of the elastic ip",
+				Optional:            true,
+			},
+			"public_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "public ip address",
+				Optional:            true,
+			},
+			"elastic_ips": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(elasticIPSchema.Attributes)),
+				MarkdownDescription: "list of elastic ips matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeElasticIPsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeElasticIPsDataSource{
+		elasticIPService: compute.NewElasticIPService(prov.client),
+	}, diagnostics
+}
+
+type computeElasticIPsDataSource struct {
+	elasticIPService compute.ElasticIPService
+}
+
+func (c computeElasticIPsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeElasticIPsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.elasticIPService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list elastic ips: %s", err))
+		return
+	}
+
+	elasticIPs := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(elasticIPs, func(elasticIP compute.ElasticIP) int { return elasticIP.ID })
+	state.ElasticIPs = make([]computeElasticIPDataSourceData, len(elasticIPs))
+	for idx, elasticIP := range elasticIPs {
+		state.ElasticIPs[idx].FromEntity(elasticIP)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
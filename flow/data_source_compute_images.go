@@ -0,0 +1,131 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeImagesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeImagesDataSource)(nil)
+)
+
+type computeImagesDataSourceData struct {
+	ID              types.String                 `tfsdk:"id"`
+	OperatingSystem types.String                 `tfsdk:"operating_system"`
+	Version         types.String                 `tfsdk:"version"`
+	Key             types.String                 `tfsdk:"key"`
+	Category        types.String                 `tfsdk:"category"`
+	Type            types.String                 `tfsdk:"type"`
+	Images          []computeImageDataSourceData `tfsdk:"images"`
+}
+
+func (i computeImagesDataSourceData) AppliesTo(image compute.Image) bool {
+	return computeImageDataSourceData{
+		ID:              types.Int64{Null: true},
+		OperatingSystem: i.OperatingSystem,
+		Version:         i.Version,
+		Key:             i.Key,
+		Category:        i.Category,
+		Type:            i.Type,
+	}.AppliesTo(image)
+}
+
+type computeImagesDataSourceType struct{}
+
+func (i computeImagesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	imageSchema, diagnostics := computeImageDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching images",
+				Computed:            true,
+			},
+			"operating_system": {
+				Type:                types.StringType,
+				MarkdownDescription: "operating system of the image",
+				Optional:            true,
+			},
+			"version": {
+				Type:                types.StringType,
+				MarkdownDescription: "version of the image",
+				Optional:            true,
+			},
+			"key": {
+				Type:                types.StringType,
+				MarkdownDescription: "unique key of the image",
+				Optional:            true,
+			},
+			"category": {
+				Type:                types.StringType,
+				MarkdownDescription: "category of the image (e.g. 'linux', 'windows')",
+				Optional:            true,
+			},
+			"type": {
+				Type:                types.StringType,
+				MarkdownDescription: "type of the image",
+				Optional:            true,
+			},
+			"images": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(imageSchema.Attributes)),
+				MarkdownDescription: "list of images matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (i computeImagesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeImagesDataSource{
+		imageService: compute.NewImageService(prov.client),
+	}, diagnostics
+}
+
+type computeImagesDataSource struct {
+	imageService compute.ImageService
+}
+
+func (i computeImagesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeImagesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := i.imageService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list images: %s", err))
+		return
+	}
+
+	images := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(images, func(image compute.Image) int { return image.ID })
+	state.Images = make([]computeImageDataSourceData, len(images))
+	for idx, image := range images {
+		state.Images[idx].FromEntity(image)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
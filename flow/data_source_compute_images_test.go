@@ -0,0 +1,32 @@
+package flow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeImagesDataSource_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImagesDataSourceConfigBasic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.flow_compute_images.linux", "id"),
+					resource.TestCheckResourceAttrSet("data.flow_compute_images.linux", "images.0.id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.flow_compute_images.linux", "images.*", map[string]string{
+						"operating_system": "Ubuntu",
+						"category":         "linux",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeImagesDataSourceConfigBasic = `
+data "flow_compute_images" "linux" {
+	category = "linux"
+}
+`
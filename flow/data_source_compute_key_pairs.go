@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeKeyPairsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeKeyPairsDataSource)(nil)
+)
+
+type computeKeyPairsDataSourceData struct {
+	ID          types.String                   `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	Fingerprint types.String                   `tfsdk:"fingerprint"`
+	KeyPairs    []computeKeyPairDataSourceData `tfsdk:"key_pairs"`
+}
+
+func (c computeKeyPairsDataSourceData) AppliesTo(keyPair compute.KeyPair) bool {
+	return computeKeyPairDataSourceData{
+		ID:          types.Int64{Null: true},
+		Name:        c.Name,
+		Fingerprint: c.Fingerprint,
+	}.AppliesTo(keyPair)
+}
+
+type computeKeyPairsDataSourceType struct{}
+
+func (c computeKeyPairsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	keyPairSchema, diagnostics := computeKeyPairDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching key pairs",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the key pair",
+				Optional:            true,
+			},
+			"fingerprint": {
+				Type:                types.StringType,
+				MarkdownDescription: "fingerprint of the key pair",
+				Optional:            true,
+			},
+			"key_pairs": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(keyPairSchema.Attributes)),
+				MarkdownDescription: "list of key pairs matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeKeyPairsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeKeyPairsDataSource{
+		keyPairService: compute.NewKeyPairService(prov.client),
+	}, diagnostics
+}
+
+type computeKeyPairsDataSource struct {
+	keyPairService compute.KeyPairService
+}
+
+func (c computeKeyPairsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeKeyPairsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.keyPairService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list key pairs: %s", err))
+		return
+	}
+
+	keyPairs := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(keyPairs, func(keyPair compute.KeyPair) int { return keyPair.ID })
+	state.KeyPairs = make([]computeKeyPairDataSourceData, len(keyPairs))
+	for idx, keyPair := range keyPairs {
+		state.KeyPairs[idx].FromEntity(keyPair)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeLoadBalancerAlgorithmsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeLoadBalancerAlgorithmsDataSource)(nil)
+)
+
+type computeLoadBalancerAlgorithmsDataSourceData struct {
+	ID         types.String                                 `tfsdk:"id"`
+	Name       types.String                                 `tfsdk:"name"`
+	Key        types.String                                 `tfsdk:"key"`
+	Algorithms []computeLoadBalancerAlgorithmDataSourceData `tfsdk:"algorithms"`
+}
+
+func (c computeLoadBalancerAlgorithmsDataSourceData) AppliesTo(algorithm compute.LoadBalancerAlgorithm) bool {
+	return computeLoadBalancerAlgorithmDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: c.Name,
+		Key:  c.Key,
+	}.AppliesTo(algorithm)
+}
+
+type computeLoadBalancerAlgorithmsDataSourceType struct{}
+
+func (c computeLoadBalancerAlgorithmsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	algorithmSchema, diagnostics := computeLoadBalancerAlgorithmDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching load balancer algorithms",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the load balancer algorithm",
+				Optional:            true,
+			},
+			"key": {
+				Type:                types.StringType,
+				MarkdownDescription: "unique key of the load balancer algorithm",
+				Optional:            true,
+			},
+			"algorithms": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(algorithmSchema.Attributes)),
+				MarkdownDescription: "list of load balancer algorithms matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeLoadBalancerAlgorithmsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeLoadBalancerAlgorithmsDataSource{
+		loadBalancerEntityService: compute.NewLoadBalancerEntityService(prov.client),
+	}, diagnostics
+}
+
+type computeLoadBalancerAlgorithmsDataSource struct {
+	loadBalancerEntityService compute.LoadBalancerEntityService
+}
+
+func (c computeLoadBalancerAlgorithmsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeLoadBalancerAlgorithmsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.loadBalancerEntityService.ListAlgorithms(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list load balancer algorithms: %s", err))
+		return
+	}
+
+	algorithms := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(algorithms, func(algorithm compute.LoadBalancerAlgorithm) int { return algorithm.ID })
+	state.Algorithms = make([]computeLoadBalancerAlgorithmDataSourceData, len(algorithms))
+	for idx, algorithm := range algorithms {
+		state.Algorithms[idx].FromEntity(algorithm)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
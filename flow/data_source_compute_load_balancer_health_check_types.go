@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeLoadBalancerHealthCheckTypesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeLoadBalancerHealthCheckTypesDataSource)(nil)
+)
+
+type computeLoadBalancerHealthCheckTypesDataSourceData struct {
+	ID               types.String                                       `tfsdk:"id"`
+	Name             types.String                                       `tfsdk:"name"`
+	Key              types.String                                       `tfsdk:"key"`
+	HealthCheckTypes []computeLoadBalancerHealthCheckTypeDataSourceData `tfsdk:"health_check_types"`
+}
+
+func (c computeLoadBalancerHealthCheckTypesDataSourceData) AppliesTo(healthCheckType compute.LoadBalancerHealthCheckType) bool {
+	return computeLoadBalancerHealthCheckTypeDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: c.Name,
+		Key:  c.Key,
+	}.AppliesTo(healthCheckType)
+}
+
+type computeLoadBalancerHealthCheckTypesDataSourceType struct{}
+
+func (c computeLoadBalancerHealthCheckTypesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	healthCheckTypeSchema, diagnostics := computeLoadBalancerHealthCheckTypeDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching load balancer health check types",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the load balancer health check type",
+				Optional:            true,
+			},
+			"key": {
+				Type:                types.StringType,
+				MarkdownDescription: "unique key of the load balancer health check type",
+				Optional:            true,
+			},
+			"health_check_types": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(healthCheckTypeSchema.Attributes)),
+				MarkdownDescription: "list of load balancer health check types matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeLoadBalancerHealthCheckTypesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeLoadBalancerHealthCheckTypesDataSource{
+		loadBalancerEntityService: compute.NewLoadBalancerEntityService(prov.client),
+	}, diagnostics
+}
+
+type computeLoadBalancerHealthCheckTypesDataSource struct {
+	loadBalancerEntityService compute.LoadBalancerEntityService
+}
+
+func (c computeLoadBalancerHealthCheckTypesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeLoadBalancerHealthCheckTypesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.loadBalancerEntityService.ListHealthCheckTypes(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list load balancer health check types: %s", err))
+		return
+	}
+
+	healthCheckTypes := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(healthCheckTypes, func(healthCheckType compute.LoadBalancerHealthCheckType) int { return healthCheckType.ID })
+	state.HealthCheckTypes = make([]computeLoadBalancerHealthCheckTypeDataSourceData, len(healthCheckTypes))
+	for idx, healthCheckType := range healthCheckTypes {
+		state.HealthCheckTypes[idx].FromEntity(healthCheckType)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,132 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeLoadBalancerMembersDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeLoadBalancerMembersDataSource)(nil)
+)
+
+type computeLoadBalancerMembersDataSourceData struct {
+	ID             types.String                              `tfsdk:"id"`
+	LoadBalancerID types.Int64                               `tfsdk:"load_balancer_id"`
+	PoolID         types.Int64                               `tfsdk:"pool_id"`
+	Name           types.String                              `tfsdk:"name"`
+	Address        types.String                              `tfsdk:"address"`
+	Port           types.Int64                               `tfsdk:"port"`
+	Members        []computeLoadBalancerMemberDataSourceData `tfsdk:"members"`
+}
+
+func (c computeLoadBalancerMembersDataSourceData) AppliesTo(member compute.LoadBalancerMember) bool {
+	return computeLoadBalancerMemberDataSourceData{
+		ID:      types.Int64{Null: true},
+		Name:    c.Name,
+		Address: c.Address,
+		Port:    c.Port,
+	}.AppliesTo(member)
+}
+
+type computeLoadBalancerMembersDataSourceType struct{}
+
+func (c computeLoadBalancerMembersDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	memberSchema, diagnostics := computeLoadBalancerMemberDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching load balancer members",
+				Computed:            true,
+			},
+			"load_balancer_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the load balancer",
+				Required:            true,
+			},
+			"pool_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the load balancer pool",
+				Required:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the load balancer member",
+				Optional:            true,
+			},
+			"address": {
+				Type:                types.StringType,
+				MarkdownDescription: "IP address of the load balancer member",
+				Optional:            true,
+			},
+			"port": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "port of the load balancer member",
+				Optional:            true,
+			},
+			"members": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(memberSchema.Attributes)),
+				MarkdownDescription: "list of load balancer members matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeLoadBalancerMembersDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeLoadBalancerMembersDataSource{
+		loadBalancerService: compute.NewLoadBalancerService(prov.client),
+	}, diagnostics
+}
+
+type computeLoadBalancerMembersDataSource struct {
+	loadBalancerService compute.LoadBalancerService
+}
+
+func (c computeLoadBalancerMembersDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeLoadBalancerMembersDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	loadBalancerID := int(config.LoadBalancerID.Value)
+	poolID := int(config.PoolID.Value)
+
+	list, err := c.loadBalancerService.Pools(loadBalancerID).Members(poolID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list load balancer members: %s", err))
+		return
+	}
+
+	members := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(members, func(member compute.LoadBalancerMember) int { return member.ID })
+	state.Members = make([]computeLoadBalancerMemberDataSourceData, len(members))
+	for idx, member := range members {
+		state.Members[idx].FromEntity(loadBalancerID, poolID, member)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,132 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeLoadBalancerPoolsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeLoadBalancerPoolsDataSource)(nil)
+)
+
+type computeLoadBalancerPoolsDataSourceData struct {
+	ID                   types.String                            `tfsdk:"id"`
+	LoadBalancerID       types.Int64                             `tfsdk:"load_balancer_id"`
+	BalancingAlgorithmID types.Int64                             `tfsdk:"balancing_algorithm_id"`
+	EntryProtocolID      types.Int64                             `tfsdk:"entry_protocol_id"`
+	EntryPort            types.Int64                             `tfsdk:"entry_port"`
+	TargetProtocolID     types.Int64                             `tfsdk:"target_protocol_id"`
+	Pools                []computeLoadBalancerPoolDataSourceData `tfsdk:"pools"`
+}
+
+func (c computeLoadBalancerPoolsDataSourceData) AppliesTo(pool compute.LoadBalancerPool) bool {
+	return computeLoadBalancerPoolDataSourceData{
+		ID:                   types.Int64{Null: true},
+		BalancingAlgorithmID: c.BalancingAlgorithmID,
+		EntryProtocolID:      c.EntryProtocolID,
+		EntryPort:            c.EntryPort,
+		TargetProtocolID:     c.TargetProtocolID,
+	}.AppliesTo(pool)
+}
+
+type computeLoadBalancerPoolsDataSourceType struct{}
+
+func (c computeLoadBalancerPoolsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	poolSchema, diagnostics := computeLoadBalancerPoolDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching load balancer pools",
+				Computed:            true,
+			},
+			"load_balancer_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the load balancer",
+				Required:            true,
+			},
+			"balancing_algorithm_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the balancing algorithm",
+				Optional:            true,
+			},
+			"entry_protocol_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the entry protocol",
+				Optional:            true,
+			},
+			"entry_port": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "entry port of the load balancer pool",
+				Optional:            true,
+			},
+			"target_protocol_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the target protocol",
+				Optional:            true,
+			},
+			"pools": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(poolSchema.Attributes)),
+				MarkdownDescription: "list of load balancer pools matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeLoadBalancerPoolsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeLoadBalancerPoolsDataSource{
+		loadBalancerService: compute.NewLoadBalancerService(prov.client),
+	}, diagnostics
+}
+
+type computeLoadBalancerPoolsDataSource struct {
+	loadBalancerService compute.LoadBalancerService
+}
+
+func (c computeLoadBalancerPoolsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeLoadBalancerPoolsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	loadBalancerID := int(config.LoadBalancerID.Value)
+
+	list, err := c.loadBalancerService.Pools(loadBalancerID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list load balancer pools: %s", err))
+		return
+	}
+
+	pools := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(pools, func(pool compute.LoadBalancerPool) int { return pool.ID })
+	state.Pools = make([]computeLoadBalancerPoolDataSourceData, len(pools))
+	for idx, pool := range pools {
+		state.Pools[idx].FromEntity(loadBalancerID, pool)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
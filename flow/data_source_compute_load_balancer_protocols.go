@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeLoadBalancerProtocolsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeLoadBalancerProtocolsDataSource)(nil)
+)
+
+type computeLoadBalancerProtocolsDataSourceData struct {
+	ID        types.String                                `tfsdk:"id"`
+	Name      types.String                                `tfsdk:"name"`
+	Key       types.String                                `tfsdk:"key"`
+	Protocols []computeLoadBalancerProtocolDataSourceData `tfsdk:"protocols"`
+}
+
+func (c computeLoadBalancerProtocolsDataSourceData) AppliesTo(protocol compute.LoadBalancerProtocol) bool {
+	return computeLoadBalancerProtocolDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: c.Name,
+		Key:  c.Key,
+	}.AppliesTo(protocol)
+}
+
+type computeLoadBalancerProtocolsDataSourceType struct{}
+
+func (c computeLoadBalancerProtocolsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	protocolSchema, diagnostics := computeLoadBalancerProtocolDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching load balancer protocols",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the load balancer protocol",
+				Optional:            true,
+			},
+			"key": {
+				Type:                types.StringType,
+				MarkdownDescription: "unique key of the load balancer protocol",
+				Optional:            true,
+			},
+			"protocols": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(protocolSchema.Attributes)),
+				MarkdownDescription: "list of load balancer protocols matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeLoadBalancerProtocolsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeLoadBalancerProtocolsDataSource{
+		loadBalancerEntityService: compute.NewLoadBalancerEntityService(prov.client),
+	}, diagnostics
+}
+
+type computeLoadBalancerProtocolsDataSource struct {
+	loadBalancerEntityService compute.LoadBalancerEntityService
+}
+
+func (c computeLoadBalancerProtocolsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeLoadBalancerProtocolsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.loadBalancerEntityService.ListProtocols(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list load balancer protocols: %s", err))
+		return
+	}
+
+	protocols := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(protocols, func(protocol compute.LoadBalancerProtocol) int { return protocol.ID })
+	state.Protocols = make([]computeLoadBalancerProtocolDataSourceData, len(protocols))
+	for idx, protocol := range protocols {
+		state.Protocols[idx].FromEntity(protocol)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,125 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeNetworkInterfacesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeNetworkInterfacesDataSource)(nil)
+)
+
+type computeNetworkInterfacesDataSourceData struct {
+	ID                types.String                            `tfsdk:"id"`
+	ServerID          types.Int64                             `tfsdk:"server_id"`
+	NetworkID         types.Int64                             `tfsdk:"network_id"`
+	PrivateIP         types.String                            `tfsdk:"private_ip"`
+	MacAddress        types.String                            `tfsdk:"mac_address"`
+	NetworkInterfaces []computeNetworkInterfaceDataSourceData `tfsdk:"network_interfaces"`
+}
+
+func (c computeNetworkInterfacesDataSourceData) AppliesTo(iface compute.NetworkInterface) bool {
+	return computeNetworkInterfaceDataSourceData{
+		ID:         types.Int64{Null: true},
+		NetworkID:  c.NetworkID,
+		PrivateIP:  c.PrivateIP,
+		MacAddress: c.MacAddress,
+	}.AppliesTo(iface)
+}
+
+type computeNetworkInterfacesDataSourceType struct{}
+
+func (c computeNetworkInterfacesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	ifaceSchema, diagnostics := computeNetworkInterfaceDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching network interfaces",
+				Computed:            true,
+			},
+			"server_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the server",
+				Required:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network",
+				Optional:            true,
+			},
+			"private_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "private IP address of the network interface",
+				Optional:            true,
+			},
+			"mac_address": {
+				Type:                types.StringType,
+				MarkdownDescription: "MAC address of the network interface",
+				Optional:            true,
+			},
+			"network_interfaces": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(ifaceSchema.Attributes)),
+				MarkdownDescription: "list of network interfaces matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeNetworkInterfacesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeNetworkInterfacesDataSource{
+		serverService: compute.NewServerService(prov.client),
+	}, diagnostics
+}
+
+type computeNetworkInterfacesDataSource struct {
+	serverService compute.ServerService
+}
+
+func (c computeNetworkInterfacesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeNetworkInterfacesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(config.ServerID.Value)
+
+	list, err := c.serverService.NetworkInterfaces(serverID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list network interfaces: %s", err))
+		return
+	}
+
+	ifaces := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(ifaces, func(iface compute.NetworkInterface) int { return iface.ID })
+	state.NetworkInterfaces = make([]computeNetworkInterfaceDataSourceData, len(ifaces))
+	for idx, iface := range ifaces {
+		state.NetworkInterfaces[idx].FromEntity(serverID, iface)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,103 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeNetworksDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeNetworksDataSource)(nil)
+)
+
+type computeNetworksDataSourceData struct {
+	ID       types.String                   `tfsdk:"id"`
+	Name     types.String                   `tfsdk:"name"`
+	Networks []computeNetworkDataSourceData `tfsdk:"networks"`
+}
+
+func (c computeNetworksDataSourceData) AppliesTo(network compute.Network) bool {
+	return computeNetworkDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: c.Name,
+	}.AppliesTo(network)
+}
+
+type computeNetworksDataSourceType struct{}
+
+func (c computeNetworksDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	networkSchema, diagnostics := computeNetworkDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching networks",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the network",
+				Optional:            true,
+			},
+			"networks": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(networkSchema.Attributes)),
+				MarkdownDescription: "list of networks matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeNetworksDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeNetworksDataSource{
+		networkService: compute.NewNetworkService(prov.client),
+	}, diagnostics
+}
+
+type computeNetworksDataSource struct {
+	networkService compute.NetworkService
+}
+
+func (c computeNetworksDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeNetworksDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.networkService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list networks: %s", err))
+		return
+	}
+
+	networks := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(networks, func(network compute.Network) int { return network.ID })
+	state.Networks = make([]computeNetworkDataSourceData, len(networks))
+	for idx, network := range networks {
+		state.Networks[idx].FromEntity(network)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
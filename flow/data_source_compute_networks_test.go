@@ -0,0 +1,55 @@
+package flow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeNetworksDataSource_Filter(t *testing.T) {
+	networkName := acctest.RandomWithPrefix("test-network")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeNetworksDataSourceConfigFilter, networkName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_compute_networks.none", "networks.#", "0"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_networks.name", "networks.#", "2"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_networks.name", "networks.0.id", "flow_compute_network.first", "id"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_networks.name", "networks.1.id", "flow_compute_network.second", "id"),
+					resource.TestCheckResourceAttr("data.flow_compute_networks.name", "networks.0.cidr", "192.168.10.0/24"),
+					resource.TestCheckResourceAttr("data.flow_compute_networks.name", "networks.1.cidr", "192.168.11.0/24"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeNetworksDataSourceConfigFilter = `
+resource "flow_compute_network" "first" {
+	name        = "%[1]s"
+	cidr        = "192.168.10.0/24"
+	location_id = 1
+}
+
+resource "flow_compute_network" "second" {
+	name        = "%[1]s"
+	cidr        = "192.168.11.0/24"
+	location_id = 1
+
+	depends_on = [flow_compute_network.first]
+}
+
+data "flow_compute_networks" "none" {
+	name = "%[1]s-missing"
+}
+
+data "flow_compute_networks" "name" {
+	name = flow_compute_network.second.name
+}
+`
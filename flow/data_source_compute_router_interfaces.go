@@ -0,0 +1,118 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeRouterInterfacesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeRouterInterfacesDataSource)(nil)
+)
+
+type computeRouterInterfacesDataSourceData struct {
+	ID               types.String                           `tfsdk:"id"`
+	RouterID         types.Int64                            `tfsdk:"router_id"`
+	NetworkID        types.Int64                            `tfsdk:"network_id"`
+	PrivateIP        types.String                           `tfsdk:"private_ip"`
+	RouterInterfaces []computeRouterInterfaceDataSourceData `tfsdk:"router_interfaces"`
+}
+
+func (c computeRouterInterfacesDataSourceData) AppliesTo(routerInterface compute.RouterInterface) bool {
+	return computeRouterInterfaceDataSourceData{
+		ID:        types.Int64{Null: true},
+		NetworkID: c.NetworkID,
+		PrivateIP: c.PrivateIP,
+	}.AppliesTo(routerInterface)
+}
+
+type computeRouterInterfacesDataSourceType struct{}
+
+func (c computeRouterInterfacesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	routerInterfaceSchema, diagnostics := computeRouterInterfaceDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching router interfaces",
+				Computed:            true,
+			},
+			"router_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the router",
+				Required:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network",
+				Optional:            true,
+			},
+			"private_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "private IP address of the router interface",
+				Optional:            true,
+			},
+			"router_interfaces": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(routerInterfaceSchema.Attributes)),
+				MarkdownDescription: "list of router interfaces matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeRouterInterfacesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeRouterInterfacesDataSource{
+		client: prov.client,
+	}, diagnostics
+}
+
+type computeRouterInterfacesDataSource struct {
+	client goclient.Client
+}
+
+func (c computeRouterInterfacesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeRouterInterfacesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	routerID := int(config.RouterID.Value)
+
+	list, err := compute.NewRouterInterfaceService(c.client, routerID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list router interfaces: %s", err))
+		return
+	}
+
+	routerInterfaces := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(routerInterfaces, func(routerInterface compute.RouterInterface) int { return routerInterface.ID })
+	state.RouterInterfaces = make([]computeRouterInterfaceDataSourceData, len(routerInterfaces))
+	for idx, routerInterface := range routerInterfaces {
+		state.RouterInterfaces[idx].FromEntity(routerID, routerInterface)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
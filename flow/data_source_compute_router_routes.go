@@ -0,0 +1,118 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeRouterRoutesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeRouterRoutesDataSource)(nil)
+)
+
+type computeRouterRoutesDataSourceData struct {
+	ID          types.String                       `tfsdk:"id"`
+	RouterID    types.Int64                        `tfsdk:"router_id"`
+	Destination types.String                       `tfsdk:"destination"`
+	NextHop     types.String                       `tfsdk:"next_hop"`
+	Routes      []computeRouterRouteDataSourceData `tfsdk:"routes"`
+}
+
+func (c computeRouterRoutesDataSourceData) AppliesTo(route compute.Route) bool {
+	return computeRouterRouteDataSourceData{
+		ID:          types.Int64{Null: true},
+		Destination: c.Destination,
+		NextHop:     c.NextHop,
+	}.AppliesTo(route)
+}
+
+type computeRouterRoutesDataSourceType struct{}
+
+func (c computeRouterRoutesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	routeSchema, diagnostics := computeRouterRouteDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching routes",
+				Computed:            true,
+			},
+			"router_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the router",
+				Required:            true,
+			},
+			"destination": {
+				Type:                types.StringType,
+				MarkdownDescription: "IP destination range of the route",
+				Optional:            true,
+			},
+			"next_hop": {
+				Type:                types.StringType,
+				MarkdownDescription: "IP address of the next hop",
+				Optional:            true,
+			},
+			"routes": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(routeSchema.Attributes)),
+				MarkdownDescription: "list of routes matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeRouterRoutesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeRouterRoutesDataSource{
+		client: prov.client,
+	}, diagnostics
+}
+
+type computeRouterRoutesDataSource struct {
+	client goclient.Client
+}
+
+func (c computeRouterRoutesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeRouterRoutesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	routerID := int(config.RouterID.Value)
+
+	list, err := compute.NewRouteService(c.client, routerID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list routes: %s", err))
+		return
+	}
+
+	routes := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(routes, func(route compute.Route) int { return route.ID })
+	state.Routes = make([]computeRouterRouteDataSourceData, len(routes))
+	for idx, route := range routes {
+		state.Routes[idx].FromEntity(routerID, route)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
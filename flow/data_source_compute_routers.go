@@ -0,0 +1,103 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeRoutersDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeRoutersDataSource)(nil)
+)
+
+type computeRoutersDataSourceData struct {
+	ID      types.String                  `tfsdk:"id"`
+	Name    types.String                  `tfsdk:"name"`
+	Routers []computeRouterDataSourceData `tfsdk:"routers"`
+}
+
+func (c computeRoutersDataSourceData) AppliesTo(router compute.Router) bool {
+	return computeRouterDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: c.Name,
+	}.AppliesTo(router)
+}
+
+type computeRoutersDataSourceType struct{}
+
+func (c computeRoutersDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	routerSchema, diagnostics := computeRouterDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching routers",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the router",
+				Optional:            true,
+			},
+			"routers": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(routerSchema.Attributes)),
+				MarkdownDescription: "list of routers matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeRoutersDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeRoutersDataSource{
+		routerService: compute.NewRouterService(prov.client),
+	}, diagnostics
+}
+
+type computeRoutersDataSource struct {
+	routerService compute.RouterService
+}
+
+func (c computeRoutersDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeRoutersDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.routerService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list routers: %s", err))
+		return
+	}
+
+	routers := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(routers, func(router compute.Router) int { return router.ID })
+	state.Routers = make([]computeRouterDataSourceData, len(routers))
+	for idx, router := range routers {
+		state.Routers[idx].FromEntity(router)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeSecurityGroupsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeSecurityGroupsDataSource)(nil)
+)
+
+type computeSecurityGroupsDataSourceData struct {
+	ID             types.String                         `tfsdk:"id"`
+	Name           types.String                         `tfsdk:"name"`
+	LocationID     types.Int64                          `tfsdk:"location_id"`
+	SecurityGroups []computeSecurityGroupDataSourceData `tfsdk:"security_groups"`
+}
+
+func (c computeSecurityGroupsDataSourceData) AppliesTo(securityGroup compute.SecurityGroup) bool {
+	return computeSecurityGroupDataSourceData{
+		ID:         types.Int64{Null: true},
+		Name:       c.Name,
+		LocationID: c.LocationID,
+	}.AppliesTo(securityGroup)
+}
+
+type computeSecurityGroupsDataSourceType struct{}
+
+func (c computeSecurityGroupsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	securityGroupSchema, diagnostics := computeSecurityGroupDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching security groups",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the security group",
+				Optional:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the location",
+				Optional:            true,
+			},
+			"security_groups": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(securityGroupSchema.Attributes)),
+				MarkdownDescription: "list of security groups matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeSecurityGroupsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeSecurityGroupsDataSource{
+		securityGroupService: compute.NewSecurityGroupService(prov.client),
+	}, diagnostics
+}
+
+type computeSecurityGroupsDataSource struct {
+	securityGroupService compute.SecurityGroupService
+}
+
+func (c computeSecurityGroupsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeSecurityGroupsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.securityGroupService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list security groups: %s", err))
+		return
+	}
+
+	securityGroups := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(securityGroups, func(securityGroup compute.SecurityGroup) int { return securityGroup.ID })
+	state.SecurityGroups = make([]computeSecurityGroupDataSourceData, len(securityGroups))
+	for idx, securityGroup := range securityGroups {
+		state.SecurityGroups[idx].FromEntity(securityGroup)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
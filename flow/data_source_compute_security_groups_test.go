@@ -0,0 +1,40 @@
+package flow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeSecurityGroupsDataSource_Basic(t *testing.T) {
+	securityGroupName := acctest.RandomWithPrefix("test-security-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeSecurityGroupsDataSourceConfigBasic, securityGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_compute_security_groups.foobar", "security_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_security_groups.foobar", "security_groups.0.id", "flow_compute_security_group.foobar", "id"),
+					resource.TestCheckResourceAttr("data.flow_compute_security_groups.foobar", "security_groups.0.name", securityGroupName),
+					resource.TestCheckResourceAttr("data.flow_compute_security_groups.foobar", "security_groups.0.location_id", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeSecurityGroupsDataSourceConfigBasic = `
+resource "flow_compute_security_group" "foobar" {
+	name        = "%s"
+	location_id = 1
+}
+
+data "flow_compute_security_groups" "foobar" {
+	name        = flow_compute_security_group.foobar.name
+	location_id = 1
+}
+`
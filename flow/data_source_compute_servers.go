@@ -0,0 +1,131 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeServersDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeServersDataSource)(nil)
+)
+
+type computeServersDataSourceData struct {
+	ID         types.String                  `tfsdk:"id"`
+	Name       types.String                  `tfsdk:"name"`
+	LocationID types.Int64                   `tfsdk:"location_id"`
+	ImageID    types.Int64                   `tfsdk:"image_id"`
+	ProductID  types.Int64                   `tfsdk:"product_id"`
+	KeyPairID  types.Int64                   `tfsdk:"key_pair_id"`
+	Servers    []computeServerDataSourceData `tfsdk:"servers"`
+}
+
+func (c computeServersDataSourceData) AppliesTo(server compute.Server) bool {
+	return computeServerDataSourceData{
+		ID:         types.Int64{Null: true},
+		Name:       c.Name,
+		LocationID: c.LocationID,
+		ImageID:    c.ImageID,
+		ProductID:  c.ProductID,
+		KeyPairID:  c.KeyPairID,
+	}.AppliesTo(server)
+}
+
+type computeServersDataSourceType struct{}
+
+func (c computeServersDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	serverSchema, diagnostics := computeServerDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching servers",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the server",
+				Optional:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the location",
+				Optional:            true,
+			},
+			"image_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the image",
+				Optional:            true,
+			},
+			"product_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the product",
+				Optional:            true,
+			},
+			"key_pair_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the key pair",
+				Optional:            true,
+			},
+			"servers": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(serverSchema.Attributes)),
+				MarkdownDescription: "list of servers matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeServersDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeServersDataSource{
+		serverService: compute.NewServerService(prov.client),
+	}, diagnostics
+}
+
+type computeServersDataSource struct {
+	serverService compute.ServerService
+}
+
+func (c computeServersDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeServersDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.serverService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list servers: %s", err))
+		return
+	}
+
+	servers := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(servers, func(server compute.Server) int { return server.ID })
+	state.Servers = make([]computeServerDataSourceData, len(servers))
+	for idx, server := range servers {
+		state.Servers[idx].FromEntity(server)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
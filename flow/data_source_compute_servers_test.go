@@ -0,0 +1,95 @@
+package flow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeServersDataSource_Filter(t *testing.T) {
+	serverName := acctest.RandomWithPrefix("test-server")
+	public, _, err := acctest.RandSSHKeyPair("test-key-pair")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeServersDataSourceConfigFilter, serverName, public),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_compute_servers.none", "servers.#", "0"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_servers.name", "servers.#", "2"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_servers.name", "servers.0.id", "flow_compute_server.small", "id"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_servers.name", "servers.1.id", "flow_compute_server.large", "id"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_servers.location", "servers.#", "2"),
+					resource.TestCheckResourceAttr("data.flow_compute_servers.image", "servers.#", "2"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_servers.product", "servers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_servers.product", "servers.0.id", "flow_compute_server.large", "id"),
+					resource.TestCheckResourceAttr("data.flow_compute_servers.product", "servers.0.product_id", "2"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_servers.key_pair", "servers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_servers.key_pair", "servers.0.id", "flow_compute_server.small", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeServersDataSourceConfigFilter = `
+resource "flow_compute_key_pair" "foobar" {
+	name       = "%[1]s"
+	public_key = "%[2]s"
+}
+
+resource "flow_compute_server" "small" {
+	name        = "%[1]s"
+	location    = "ALP1"
+	image       = "linux-ubuntu-22.04-lts"
+	product     = "b1.1x1"
+	key_pair_id = flow_compute_key_pair.foobar.id
+}
+
+resource "flow_compute_server" "large" {
+	name     = "%[1]s"
+	location = "ALP1"
+	image    = "linux-ubuntu-22.04-lts"
+	product  = "b1.2x2"
+
+	depends_on = [flow_compute_server.small]
+}
+
+data "flow_compute_servers" "none" {
+	name = "%[1]s-missing"
+}
+
+data "flow_compute_servers" "name" {
+	name = flow_compute_server.large.name
+}
+
+data "flow_compute_servers" "location" {
+	name        = flow_compute_server.large.name
+	location_id = flow_compute_server.large.location_id
+}
+
+data "flow_compute_servers" "image" {
+	name     = flow_compute_server.large.name
+	image_id = flow_compute_server.large.image_id
+}
+
+data "flow_compute_servers" "product" {
+	name       = flow_compute_server.large.name
+	product_id = flow_compute_server.large.product_id
+}
+
+data "flow_compute_servers" "key_pair" {
+	name        = flow_compute_server.large.name
+	key_pair_id = flow_compute_key_pair.foobar.id
+}
+`
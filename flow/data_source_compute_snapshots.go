@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeSnapshotsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeSnapshotsDataSource)(nil)
+)
+
+type computeSnapshotsDataSourceData struct {
+	ID        types.String                    `tfsdk:"id"`
+	Name      types.String                    `tfsdk:"name"`
+	VolumeID  types.Int64                     `tfsdk:"volume_id"`
+	Snapshots []computeSnapshotDataSourceData `tfsdk:"snapshots"`
+}
+
+func (c computeSnapshotsDataSourceData) AppliesTo(snapshot compute.Snapshot) bool {
+	return computeSnapshotDataSourceData{
+		ID:       types.Int64{Null: true},
+		Name:     c.Name,
+		VolumeID: c.VolumeID,
+	}.AppliesTo(snapshot)
+}
+
+type computeSnapshotsDataSourceType struct{}
+
+func (c computeSnapshotsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	snapshotSchema, diagnostics := computeSnapshotDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching snapshots",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the snapshot",
+				Optional:            true,
+			},
+			"volume_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the volume",
+				Optional:            true,
+			},
+			"snapshots": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(snapshotSchema.Attributes)),
+				MarkdownDescription: "list of snapshots matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeSnapshotsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeSnapshotsDataSource{
+		snapshotService: compute.NewSnapshotService(prov.client),
+	}, diagnostics
+}
+
+type computeSnapshotsDataSource struct {
+	snapshotService compute.SnapshotService
+}
+
+func (c computeSnapshotsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeSnapshotsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.snapshotService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list snapshots: %s", err))
+		return
+	}
+
+	snapshots := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(snapshots, func(snapshot compute.Snapshot) int { return snapshot.ID })
+	state.Snapshots = make([]computeSnapshotDataSourceData, len(snapshots))
+	for idx, snapshot := range snapshots {
+		state.Snapshots[idx].FromEntity(snapshot)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
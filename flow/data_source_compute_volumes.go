@@ -0,0 +1,117 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*computeVolumesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*computeVolumesDataSource)(nil)
+)
+
+type computeVolumesDataSourceData struct {
+	ID           types.String                  `tfsdk:"id"`
+	SerialNumber types.String                  `tfsdk:"serial_number"`
+	Name         types.String                  `tfsdk:"name"`
+	LocationID   types.Int64                   `tfsdk:"location_id"`
+	Volumes      []computeVolumeDataSourceData `tfsdk:"volumes"`
+}
+
+func (c computeVolumesDataSourceData) AppliesTo(volume compute.Volume) bool {
+	return computeVolumeDataSourceData{
+		ID:           types.Int64{Null: true},
+		SerialNumber: c.SerialNumber,
+		Name:         c.Name,
+		LocationID:   c.LocationID,
+	}.AppliesTo(volume)
+}
+
+type computeVolumesDataSourceType struct{}
+
+func (c computeVolumesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	volumeSchema, diagnostics := computeVolumeDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching volumes",
+				Computed:            true,
+			},
+			"serial_number": {
+				Type:                types.StringType,
+				MarkdownDescription: "unique serial number of the volume",
+				Optional:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the volume",
+				Optional:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "identifier of the location of the volume",
+				Optional:            true,
+			},
+			"volumes": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(volumeSchema.Attributes)),
+				MarkdownDescription: "list of volumes matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c computeVolumesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return computeVolumesDataSource{
+		volumeService: compute.NewVolumeService(prov.client),
+	}, diagnostics
+}
+
+type computeVolumesDataSource struct {
+	volumeService compute.VolumeService
+}
+
+func (c computeVolumesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config computeVolumesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.volumeService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list volumes: %s", err))
+		return
+	}
+
+	volumes := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(volumes, func(volume compute.Volume) int { return volume.ID })
+	state.Volumes = make([]computeVolumeDataSourceData, len(volumes))
+	for idx, volume := range volumes {
+		state.Volumes[idx].FromEntity(volume)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
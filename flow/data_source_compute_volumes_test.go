@@ -0,0 +1,70 @@
+package flow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeVolumesDataSource_Filter(t *testing.T) {
+	volumeName := acctest.RandomWithPrefix("test-volume")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeVolumesDataSourceConfigFilter, volumeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_compute_volumes.none", "volumes.#", "0"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_volumes.name", "volumes.#", "2"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_volumes.name", "volumes.0.id", "flow_compute_volume.first", "id"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_volumes.name", "volumes.1.id", "flow_compute_volume.second", "id"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_volumes.location", "volumes.#", "2"),
+
+					resource.TestCheckResourceAttr("data.flow_compute_volumes.serial_number", "volumes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_compute_volumes.serial_number", "volumes.0.id", "flow_compute_volume.second", "id"),
+					resource.TestCheckResourceAttr("data.flow_compute_volumes.serial_number", "volumes.0.size", "20"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeVolumesDataSourceConfigFilter = `
+resource "flow_compute_volume" "first" {
+	name        = "%[1]s"
+	location_id = 1
+
+	size = 10
+}
+
+resource "flow_compute_volume" "second" {
+	name        = "%[1]s"
+	location_id = 1
+
+	size = 20
+
+	depends_on = [flow_compute_volume.first]
+}
+
+data "flow_compute_volumes" "none" {
+	name = "%[1]s-missing"
+}
+
+data "flow_compute_volumes" "name" {
+	name = flow_compute_volume.second.name
+}
+
+data "flow_compute_volumes" "location" {
+	name        = flow_compute_volume.second.name
+	location_id = flow_compute_volume.second.location_id
+}
+
+data "flow_compute_volumes" "serial_number" {
+	serial_number = flow_compute_volume.second.serial_number
+}
+`
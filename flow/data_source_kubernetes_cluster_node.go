@@ -0,0 +1,196 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/kubernetes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*kubernetesClusterNodeDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*kubernetesClusterNodeDataSource)(nil)
+)
+
+type kubernetesClusterNodeDataSourceData struct {
+	ID        types.Int64  `tfsdk:"id"`
+	ClusterID types.Int64  `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+	Role      types.String `tfsdk:"role"`
+	ProductID types.Int64  `tfsdk:"product_id"`
+	Status    types.String `tfsdk:"status"`
+
+	NetworkID          types.Int64  `tfsdk:"network_id"`
+	NetworkInterfaceID types.Int64  `tfsdk:"network_interface_id"`
+	PrivateIP          types.String `tfsdk:"private_ip"`
+	PublicIP           types.String `tfsdk:"public_ip"`
+}
+
+func (k *kubernetesClusterNodeDataSourceData) FromEntity(clusterID int, node kubernetes.Node) {
+	k.ID = types.Int64{Value: int64(node.ID)}
+	k.ClusterID = types.Int64{Value: int64(clusterID)}
+	k.Name = types.String{Value: node.Name}
+
+	k.Role = types.String{Null: true}
+	if len(node.Roles) != 0 {
+		k.Role = types.String{Value: node.Roles[0].Key}
+	}
+
+	k.ProductID = types.Int64{Value: int64(node.Product.ID)}
+	k.Status = types.String{Value: node.Status.Key}
+
+	k.NetworkID = types.Int64{Value: int64(node.Network.ID)}
+	k.NetworkInterfaceID = types.Int64{Null: true}
+	k.PrivateIP = types.String{Null: true}
+	k.PublicIP = types.String{Null: true}
+
+	if len(node.Network.Interfaces) != 0 {
+		networkInterface := node.Network.Interfaces[0]
+
+		k.NetworkInterfaceID = types.Int64{Value: int64(networkInterface.ID)}
+		k.PrivateIP = optionalString(networkInterface.PrivateIP)
+		k.PublicIP = optionalString(networkInterface.PublicIP)
+	}
+}
+
+func (k kubernetesClusterNodeDataSourceData) AppliesTo(node kubernetes.Node) bool {
+	if !k.ID.Null && k.ID.Value != int64(node.ID) {
+		return false
+	}
+
+	if !k.Name.Null && k.Name.Value != node.Name {
+		return false
+	}
+
+	if !k.Role.Null && !kubernetesNodeHasRole(node, k.Role.Value) {
+		return false
+	}
+
+	if !k.Status.Null && k.Status.Value != node.Status.Key {
+		return false
+	}
+
+	return true
+}
+
+func kubernetesNodeHasRole(node kubernetes.Node, role string) bool {
+	for _, candidate := range node.Roles {
+		if candidate.Key == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type kubernetesClusterNodeDataSourceType struct{}
+
+func (k kubernetesClusterNodeDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the node",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cluster_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the cluster",
+				Required:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the node",
+				Optional:            true,
+				Computed:            true,
+			},
+			"role": {
+				Type:                types.StringType,
+				MarkdownDescription: "role of the node (e.g. `control-plane` or `worker`)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"product_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the node product",
+				Computed:            true,
+			},
+			"status": {
+				Type:                types.StringType,
+				MarkdownDescription: "status of the node (e.g. `healthy`)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network the node is attached to",
+				Computed:            true,
+			},
+			"network_interface_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network interface of the node",
+				Computed:            true,
+			},
+			"private_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "private IP address of the node",
+				Computed:            true,
+			},
+			"public_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "public IP address of the node, if any",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (k kubernetesClusterNodeDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return kubernetesClusterNodeDataSource{
+		clusterService: kubernetes.NewClusterService(prov.client),
+	}, diagnostics
+}
+
+type kubernetesClusterNodeDataSource struct {
+	clusterService kubernetes.ClusterService
+}
+
+func (k kubernetesClusterNodeDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config kubernetesClusterNodeDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := int(config.ClusterID.Value)
+	list, err := k.clusterService.Nodes(clusterID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list cluster nodes: %s", err))
+		return
+	}
+
+	node, err := filter.FindOne(config, list.Items)
+	if err != nil {
+		response.Diagnostics.AddError("Not Found", fmt.Sprintf("unable to find cluster node: %s", err))
+		return
+	}
+
+	var state kubernetesClusterNodeDataSourceData
+	state.FromEntity(clusterID, node)
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
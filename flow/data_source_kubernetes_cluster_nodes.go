@@ -0,0 +1,119 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/kubernetes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*kubernetesClusterNodesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*kubernetesClusterNodesDataSource)(nil)
+)
+
+type kubernetesClusterNodesDataSourceData struct {
+	ID        types.String                          `tfsdk:"id"`
+	ClusterID types.Int64                           `tfsdk:"cluster_id"`
+	Role      types.String                          `tfsdk:"role"`
+	Status    types.String                          `tfsdk:"status"`
+	Nodes     []kubernetesClusterNodeDataSourceData `tfsdk:"nodes"`
+}
+
+func (k kubernetesClusterNodesDataSourceData) AppliesTo(node kubernetes.Node) bool {
+	return kubernetesClusterNodeDataSourceData{
+		ID:     types.Int64{Null: true},
+		Name:   types.String{Null: true},
+		Role:   k.Role,
+		Status: k.Status,
+	}.AppliesTo(node)
+}
+
+type kubernetesClusterNodesDataSourceType struct{}
+
+func (k kubernetesClusterNodesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	nodeSchema, diagnostics := kubernetesClusterNodeDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching nodes",
+				Computed:            true,
+			},
+			"cluster_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the cluster",
+				Required:            true,
+			},
+			"role": {
+				Type:                types.StringType,
+				MarkdownDescription: "role of the node (e.g. `control-plane` or `worker`)",
+				Optional:            true,
+			},
+			"status": {
+				Type:                types.StringType,
+				MarkdownDescription: "status of the node (e.g. `healthy`)",
+				Optional:            true,
+			},
+			"nodes": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(nodeSchema.Attributes)),
+				MarkdownDescription: "list of nodes matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (k kubernetesClusterNodesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return kubernetesClusterNodesDataSource{
+		clusterService: kubernetes.NewClusterService(prov.client),
+	}, diagnostics
+}
+
+type kubernetesClusterNodesDataSource struct {
+	clusterService kubernetes.ClusterService
+}
+
+func (k kubernetesClusterNodesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config kubernetesClusterNodesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := int(config.ClusterID.Value)
+
+	list, err := k.clusterService.Nodes(clusterID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list cluster nodes: %s", err))
+		return
+	}
+
+	nodes := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(nodes, func(node kubernetes.Node) int { return node.ID })
+	state.Nodes = make([]kubernetesClusterNodeDataSourceData, len(nodes))
+	for idx, node := range nodes {
+		state.Nodes[idx].FromEntity(clusterID, node)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
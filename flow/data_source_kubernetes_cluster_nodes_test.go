@@ -0,0 +1,103 @@
+package flow
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKubernetesClusterNodesDataSource_Basic(t *testing.T) {
+	networkName := "default"
+	clusterName := acctest.RandomWithPrefix("test-cluster")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterNodesDataSourceConfigBasic, networkName, clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterNodeCount("data.flow_kubernetes_cluster_nodes.all", "flow_kubernetes_cluster.foobar"),
+					resource.TestCheckResourceAttr("data.flow_kubernetes_cluster_nodes.workers", "nodes.#", "2"),
+					resource.TestCheckResourceAttr("data.flow_kubernetes_cluster_nodes.workers", "nodes.0.role", "worker"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_cluster_nodes.workers", "nodes.0.product_id", "data.flow_product.foobar", "id"),
+					resource.TestCheckResourceAttr("data.flow_kubernetes_cluster_nodes.workers", "nodes.0.status", "healthy"),
+					resource.TestCheckResourceAttrSet("data.flow_kubernetes_cluster_nodes.workers", "nodes.0.network_interface_id"),
+					resource.TestCheckResourceAttrSet("data.flow_kubernetes_cluster_nodes.workers", "nodes.0.private_ip"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_cluster_node.first", "id", "data.flow_kubernetes_cluster_nodes.workers", "nodes.0.id"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_cluster_node.first", "private_ip", "data.flow_kubernetes_cluster_nodes.workers", "nodes.0.private_ip"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKubernetesClusterNodeCount checks that the nodes include the control plane and the worker nodes of the
+// cluster, as the number of control plane nodes is chosen by the platform.
+func testAccCheckKubernetesClusterNodeCount(name string, clusterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		nodes, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("data source %s not found in state", name)
+		}
+
+		cluster, ok := s.RootModule().Resources[clusterName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", clusterName)
+		}
+
+		count := 0
+		for _, attribute := range []string{"current_control_plane_node_count", "current_node_count"} {
+			value, err := strconv.Atoi(cluster.Primary.Attributes[attribute])
+			if err != nil {
+				return fmt.Errorf("invalid %s of resource %s: %w", attribute, clusterName, err)
+			}
+
+			count += value
+		}
+
+		if actual := nodes.Primary.Attributes["nodes.#"]; actual != strconv.Itoa(count) {
+			return fmt.Errorf("expected %d nodes, got %s", count, actual)
+		}
+
+		return nil
+	}
+}
+
+const testAccKubernetesClusterNodesDataSourceConfigBasic = `
+data "flow_compute_network" "foobar" {
+	name = "%s"
+}
+
+data "flow_product" "foobar" {
+	name = "Worker Small"
+	type = "kubernetes-node"
+}
+
+resource "flow_kubernetes_cluster" "foobar" {
+	name = "%s"
+
+	location   = "ALP1"
+	network_id = data.flow_compute_network.foobar.id
+
+	node_count   = 2
+	node_product = "Worker Small"
+}
+
+data "flow_kubernetes_cluster_nodes" "all" {
+	cluster_id = flow_kubernetes_cluster.foobar.id
+}
+
+data "flow_kubernetes_cluster_nodes" "workers" {
+	cluster_id = flow_kubernetes_cluster.foobar.id
+	role       = "worker"
+}
+
+data "flow_kubernetes_cluster_node" "first" {
+	cluster_id = flow_kubernetes_cluster.foobar.id
+	name       = data.flow_kubernetes_cluster_nodes.workers.nodes.0.name
+}
+`
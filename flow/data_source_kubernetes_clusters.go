@@ -0,0 +1,138 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/kubernetes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*kubernetesClustersDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*kubernetesClustersDataSource)(nil)
+)
+
+type kubernetesClustersDataSourceData struct {
+	ID              types.String                      `tfsdk:"id"`
+	Name            types.String                      `tfsdk:"name"`
+	LocationID      types.Int64                       `tfsdk:"location_id"`
+	NetworkID       types.Int64                       `tfsdk:"network_id"`
+	SecurityGroupID types.Int64                       `tfsdk:"security_group_id"`
+	PublicAddress   types.String                      `tfsdk:"public_address"`
+	DNSName         types.String                      `tfsdk:"dns_name"`
+	Clusters        []kubernetesClusterDataSourceData `tfsdk:"clusters"`
+}
+
+func (k kubernetesClustersDataSourceData) AppliesTo(cluster kubernetes.Cluster) bool {
+	return kubernetesClusterDataSourceData{
+		ID:              types.Int64{Null: true},
+		Name:            k.Name,
+		LocationID:      k.LocationID,
+		NetworkID:       k.NetworkID,
+		SecurityGroupID: k.SecurityGroupID,
+		PublicAddress:   k.PublicAddress,
+		DNSName:         k.DNSName,
+	}.AppliesTo(cluster)
+}
+
+type kubernetesClustersDataSourceType struct{}
+
+func (k kubernetesClustersDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	clusterSchema, diagnostics := kubernetesClusterDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching clusters",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the cluster",
+				Optional:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the location",
+				Optional:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network",
+				Optional:            true,
+			},
+			"security_group_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the security group",
+				Optional:            true,
+			},
+			"public_address": {
+				Type:                types.StringType,
+				MarkdownDescription: "public address of the cluster",
+				Optional:            true,
+			},
+			"dns_name": {
+				Type:                types.StringType,
+				MarkdownDescription: "DNS name of the cluster",
+				Optional:            true,
+			},
+			"clusters": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(clusterSchema.Attributes)),
+				MarkdownDescription: "list of clusters matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (k kubernetesClustersDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return kubernetesClustersDataSource{
+		clusterService: kubernetes.NewClusterService(prov.client),
+	}, diagnostics
+}
+
+type kubernetesClustersDataSource struct {
+	clusterService kubernetes.ClusterService
+}
+
+func (k kubernetesClustersDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config kubernetesClustersDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := k.clusterService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list clusters: %s", err))
+		return
+	}
+
+	clusters := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(clusters, func(cluster kubernetes.Cluster) int { return cluster.ID })
+	state.Clusters = make([]kubernetesClusterDataSourceData, len(clusters))
+	for idx, cluster := range clusters {
+		state.Clusters[idx].FromEntity(cluster)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,101 @@
+package flow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesClustersDataSource_Filter(t *testing.T) {
+	clusterName := acctest.RandomWithPrefix("test-cluster")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKubernetesClustersDataSourceConfigFilter, clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.none", "clusters.#", "0"),
+
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.name", "clusters.#", "2"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_clusters.name", "clusters.0.id", "flow_kubernetes_cluster.public", "id"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_clusters.name", "clusters.1.id", "flow_kubernetes_cluster.private", "id"),
+
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.location", "clusters.#", "2"),
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.network", "clusters.#", "2"),
+
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.security_group", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_clusters.security_group", "clusters.0.id", "flow_kubernetes_cluster.private", "id"),
+
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.public_address", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_clusters.public_address", "clusters.0.id", "flow_kubernetes_cluster.public", "id"),
+
+					resource.TestCheckResourceAttr("data.flow_kubernetes_clusters.dns_name", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_kubernetes_clusters.dns_name", "clusters.0.id", "flow_kubernetes_cluster.private", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKubernetesClustersDataSourceConfigFilter = `
+data "flow_compute_network" "foobar" {
+	name = "default"
+}
+
+resource "flow_kubernetes_cluster" "public" {
+	name = "%[1]s"
+
+	location_id = data.flow_compute_network.foobar.location_id
+	network_id  = data.flow_compute_network.foobar.id
+
+	public = true
+
+	node_count      = 3
+	node_product_id = 44
+}
+
+resource "flow_kubernetes_cluster" "private" {
+	name = "%[1]s"
+
+	location_id = data.flow_compute_network.foobar.location_id
+	network_id  = data.flow_compute_network.foobar.id
+
+	node_count      = 3
+	node_product_id = 44
+
+	depends_on = [flow_kubernetes_cluster.public]
+}
+
+data "flow_kubernetes_clusters" "none" {
+	name = "%[1]s-missing"
+}
+
+data "flow_kubernetes_clusters" "name" {
+	name = flow_kubernetes_cluster.private.name
+}
+
+data "flow_kubernetes_clusters" "location" {
+	name        = flow_kubernetes_cluster.private.name
+	location_id = flow_kubernetes_cluster.private.location_id
+}
+
+data "flow_kubernetes_clusters" "network" {
+	name       = flow_kubernetes_cluster.private.name
+	network_id = flow_kubernetes_cluster.private.network_id
+}
+
+data "flow_kubernetes_clusters" "security_group" {
+	security_group_id = flow_kubernetes_cluster.private.security_group_id
+}
+
+data "flow_kubernetes_clusters" "public_address" {
+	public_address = flow_kubernetes_cluster.public.public_address
+}
+
+data "flow_kubernetes_clusters" "dns_name" {
+	dns_name = flow_kubernetes_cluster.private.dns_name
+}
+`
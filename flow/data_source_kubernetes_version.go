@@ -0,0 +1,272 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/kubernetes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*kubernetesVersionDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*kubernetesVersionDataSource)(nil)
+)
+
+// latestKubernetesVersion selects the newest version offered by the api.
+const latestKubernetesVersion = "latest"
+
+// kubernetesVersionsPath lists the kubernetes versions offered by the api, which goclient does not provide a service for.
+const kubernetesVersionsPath = "/v4/entities/kubernetes/versions"
+
+type kubernetesVersionDataSourceData struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Version types.String `tfsdk:"version"`
+	Name    types.String `tfsdk:"name"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+}
+
+func (k *kubernetesVersionDataSourceData) FromEntity(version kubernetes.ClusterVersion) {
+	k.ID = types.Int64{Value: int64(version.ID)}
+	k.Name = types.String{Value: version.Name}
+	k.Major = types.Int64{Value: int64(version.Major)}
+	k.Minor = types.Int64{Value: int64(version.Minor)}
+}
+
+func (k kubernetesVersionDataSourceData) AppliesTo(version kubernetes.ClusterVersion) bool {
+	if !k.ID.Null && k.ID.Value != int64(version.ID) {
+		return false
+	}
+
+	if !k.Version.Null && k.Version.Value != latestKubernetesVersion {
+		prefix, err := parseSemanticVersion(k.Version.Value)
+		if err != nil {
+			return false
+		}
+
+		numbers := kubernetesVersionNumbers(version)
+		if len(numbers) < len(prefix) {
+			return false
+		}
+
+		for idx := range prefix {
+			if numbers[idx] != prefix[idx] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+type kubernetesVersionDataSourceType struct{}
+
+func (k kubernetesVersionDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "looks up a kubernetes version offered for clusters",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the kubernetes version",
+				Optional:            true,
+				Computed:            true,
+			},
+			"version": {
+				Type:                types.StringType,
+				MarkdownDescription: "semantic version to look up (e.g. `1.24` or `1.24.4`), or `latest` for the newest version. The newest version matching the given components is selected",
+				Optional:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "full semantic version of the kubernetes version",
+				Computed:            true,
+			},
+			"major": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "major version of the kubernetes version",
+				Computed:            true,
+			},
+			"minor": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "minor version of the kubernetes version",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (k kubernetesVersionDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return kubernetesVersionDataSource{
+		client: prov.client,
+	}, diagnostics
+}
+
+type kubernetesVersionDataSource struct {
+	client goclient.Client
+}
+
+func (k kubernetesVersionDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config kubernetesVersionDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Version.Null && config.Version.Value != latestKubernetesVersion {
+		if _, err := parseSemanticVersion(config.Version.Value); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("version"), "Invalid Version", err.Error())
+			return
+		}
+	}
+
+	versions, err := listKubernetesVersions(ctx, k.client)
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list kubernetes versions: %s", err))
+		return
+	}
+
+	// the versions are sorted, so the newest matching version is the last one
+	matching := filter.Find(config, versions)
+	if len(matching) == 0 {
+		response.Diagnostics.AddError("Not Found", fmt.Sprintf("unable to find kubernetes version: %s", filter.ErrNoResults))
+		return
+	}
+
+	state := config
+	state.FromEntity(matching[len(matching)-1])
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
+
+// listKubernetesVersions returns the kubernetes versions offered by the api, sorted from the oldest to the newest
+// version.
+func listKubernetesVersions(ctx context.Context, client goclient.Client) ([]kubernetes.ClusterVersion, error) {
+	var versions []kubernetes.ClusterVersion
+	_, err := client.List(ctx, kubernetesVersionsPath, goclient.Cursor{NoFilter: 1}, &versions)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return compareKubernetesVersions(versions[i], versions[j]) < 0
+	})
+
+	return versions, nil
+}
+
+// nextKubernetesVersion selects the version the cluster has to be upgraded to next on its way to the target version.
+// Minor versions can not be skipped, therefore the upgrade path with the lowest minor version not exceeding the target
+// is chosen, preferring the target itself and otherwise the newest patch release of that minor version.
+func nextKubernetesVersion(current kubernetes.ClusterVersion, target kubernetes.ClusterVersion) (next kubernetes.ClusterVersion, ok bool) {
+	for _, candidate := range current.UpgradePaths {
+		if compareKubernetesVersions(candidate, current) <= 0 || compareKubernetesVersions(candidate, target) > 0 {
+			continue
+		}
+
+		if !ok {
+			next, ok = candidate, true
+			continue
+		}
+
+		switch {
+		case candidate.Major != next.Major || candidate.Minor != next.Minor:
+			if candidate.Major < next.Major || (candidate.Major == next.Major && candidate.Minor < next.Minor) {
+				next = candidate
+			}
+		case next.ID != target.ID && (candidate.ID == target.ID || compareKubernetesVersions(candidate, next) > 0):
+			next = candidate
+		}
+	}
+
+	return
+}
+
+// compareKubernetesVersions compares the semantic versions of two kubernetes versions, returning a negative number if a
+// is older than b, a positive number if a is newer than b and zero if both are equal. A pre-release is older than the
+// release of the same version, build metadata is ignored.
+func compareKubernetesVersions(a, b kubernetes.ClusterVersion) int {
+	numbersA, numbersB := kubernetesVersionNumbers(a), kubernetesVersionNumbers(b)
+
+	for idx := 0; idx < len(numbersA) && idx < len(numbersB); idx++ {
+		if numbersA[idx] != numbersB[idx] {
+			return numbersA[idx] - numbersB[idx]
+		}
+	}
+
+	if len(numbersA) != len(numbersB) {
+		return len(numbersA) - len(numbersB)
+	}
+
+	switch preReleaseA, preReleaseB := isKubernetesPreRelease(a), isKubernetesPreRelease(b); {
+	case preReleaseA && !preReleaseB:
+		return -1
+	case !preReleaseA && preReleaseB:
+		return 1
+	}
+
+	return 0
+}
+
+// isKubernetesPreRelease reports whether the semantic version is a pre-release, such as `1.26.0-rc.1`.
+func isKubernetesPreRelease(version kubernetes.ClusterVersion) bool {
+	name := version.Name
+	if idx := strings.Index(name, "+"); idx >= 0 {
+		name = name[:idx]
+	}
+
+	return strings.Contains(name, "-")
+}
+
+// kubernetesVersionNumbers returns the numeric components of the semantic version. The major and minor version of the
+// api are used as a fallback if the name of the version can not be parsed.
+func kubernetesVersionNumbers(version kubernetes.ClusterVersion) []int {
+	numbers, err := parseSemanticVersion(version.Name)
+	if err != nil {
+		return []int{version.Major, version.Minor}
+	}
+
+	return numbers
+}
+
+// parseSemanticVersion parses the numeric components of a semantic version, such as `1.24` or `v1.24.4`. Pre-release
+// and build metadata suffixes are ignored.
+func parseSemanticVersion(version string) ([]int, error) {
+	trimmed := strings.TrimPrefix(version, "v")
+	if idx := strings.IndexAny(trimmed, "-+"); idx >= 0 {
+		trimmed = trimmed[:idx]
+	}
+
+	components := strings.Split(trimmed, ".")
+	if len(components) > 3 {
+		return nil, fmt.Errorf("%q is not a semantic version", version)
+	}
+
+	numbers := make([]int, len(components))
+	for idx, component := range components {
+		number, err := strconv.Atoi(component)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("%q is not a semantic version", version)
+		}
+
+		numbers[idx] = number
+	}
+
+	return numbers, nil
+}
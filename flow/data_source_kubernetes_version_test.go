@@ -0,0 +1,113 @@
+package flow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flowswiss/goclient/kubernetes"
+)
+
+func TestListKubernetesVersions(t *testing.T) {
+	client := newFakeAPIClient(t, fakeAPIToken)
+
+	// the versions are offered even if the account does not have any cluster yet
+	versions, err := listKubernetesVersions(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unable to list kubernetes versions: %s", err)
+	}
+
+	var names []string
+	for _, version := range versions {
+		names = append(names, version.Name)
+	}
+
+	if len(names) != 3 || names[0] != "1.23.10" || names[1] != "1.24.4" || names[2] != "1.25.0" {
+		t.Errorf("expected versions sorted from the oldest to the newest, got %v", names)
+	}
+}
+
+func TestCompareKubernetesVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.24.4", b: "1.24.4", want: 0},
+		{a: "1.23.10", b: "1.24.4", want: -1},
+		{a: "1.25.0", b: "1.24.4", want: 1},
+		{a: "1.9.0", b: "1.10.0", want: -1},
+		{a: "1.24.10", b: "1.24.9", want: 1},
+		{a: "2.0.0", b: "1.25.0", want: 1},
+		{a: "v1.24.4", b: "1.24.4", want: 0},
+		{a: "1.24", b: "1.24.0", want: -1},
+		{a: "1.25.0-rc.1", b: "1.25.0", want: -1},
+		{a: "1.25.0-rc.1", b: "1.24.4", want: 1},
+		{a: "1.24.4+flow.1", b: "1.24.4", want: 0},
+	}
+
+	for _, test := range tests {
+		got := compareKubernetesVersions(kubernetes.ClusterVersion{Name: test.a}, kubernetes.ClusterVersion{Name: test.b})
+		if sign(got) != test.want {
+			t.Errorf("compareKubernetesVersions(%s, %s) = %d, expected sign %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	// the major and minor version of the api are used if the name can not be parsed
+	got := compareKubernetesVersions(
+		kubernetes.ClusterVersion{Name: "unknown", Major: 1, Minor: 25},
+		kubernetes.ClusterVersion{Name: "unknown", Major: 1, Minor: 24},
+	)
+	if got <= 0 {
+		t.Errorf("expected the fallback to the major and minor version, got %d", got)
+	}
+}
+
+func TestNextKubernetesVersion(t *testing.T) {
+	version := func(id int, name string, upgradePaths ...kubernetes.ClusterVersion) kubernetes.ClusterVersion {
+		numbers, _ := parseSemanticVersion(name)
+		return kubernetes.ClusterVersion{ID: id, Name: name, Major: numbers[0], Minor: numbers[1], UpgradePaths: upgradePaths}
+	}
+
+	v1_24_4 := version(2, "1.24.4")
+	v1_24_6 := version(3, "1.24.6")
+	v1_25_0 := version(4, "1.25.0")
+	v1_25_2 := version(5, "1.25.2")
+	v1_26_0rc := version(6, "1.26.0-rc.1")
+	v1_26_0 := version(8, "1.26.0")
+	v1_23_10 := version(1, "1.23.10", v1_24_4, v1_24_6, v1_25_0)
+
+	tests := []struct {
+		name    string
+		current kubernetes.ClusterVersion
+		target  kubernetes.ClusterVersion
+		want    int
+		ok      bool
+	}{
+		{name: "target is an upgrade path", current: v1_23_10, target: v1_24_4, want: v1_24_4.ID, ok: true},
+		{name: "minor versions are not skipped", current: v1_23_10, target: v1_25_0, want: v1_24_6.ID, ok: true},
+		{name: "newest patch release of the next minor version", current: v1_23_10, target: v1_25_2, want: v1_24_6.ID, ok: true},
+		{name: "patch upgrade", current: version(2, "1.24.4", v1_24_6, v1_25_0), target: v1_24_6, want: v1_24_6.ID, ok: true},
+		{name: "patch release beyond the target", current: version(2, "1.24.4", v1_24_6), target: version(7, "1.24.5"), ok: false},
+		{name: "pre-release", current: version(5, "1.25.2", v1_26_0rc), target: v1_26_0rc, want: v1_26_0rc.ID, ok: true},
+		{name: "release of a pre-release", current: version(6, "1.26.0-rc.1", v1_26_0), target: v1_26_0, want: v1_26_0.ID, ok: true},
+		{name: "missing upgrade path", current: version(4, "1.25.0"), target: v1_25_2, ok: false},
+		{name: "downgrade", current: version(3, "1.24.6", v1_25_0), target: v1_24_4, ok: false},
+	}
+
+	for _, test := range tests {
+		next, ok := nextKubernetesVersion(test.current, test.target)
+		if ok != test.ok || (ok && next.ID != test.want) {
+			t.Errorf("nextKubernetesVersion(%s) = %d, %t, expected %d, %t", test.name, next.ID, ok, test.want, test.ok)
+		}
+	}
+}
+
+func sign(value int) int {
+	switch {
+	case value < 0:
+		return -1
+	case value > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -31,6 +31,10 @@ type kubernetesClusterDataSourceData struct {
 
 	NodeCount     types.Int64 `tfsdk:"node_count"`
 	NodeProductID types.Int64 `tfsdk:"node_product_id"`
+
+	CurrentNodeCount             types.Int64  `tfsdk:"current_node_count"`
+	CurrentControlPlaneNodeCount types.Int64  `tfsdk:"current_control_plane_node_count"`
+	Status                       types.String `tfsdk:"status"`
 }
 
 func (k *kubernetesClusterDataSourceData) FromEntity(cluster kubernetes.Cluster) {
@@ -53,6 +57,10 @@ func (k *kubernetesClusterDataSourceData) FromEntity(cluster kubernetes.Cluster)
 
 	k.NodeCount = types.Int64{Value: int64(cluster.NodeCount.Expected.Worker)}
 	k.NodeProductID = types.Int64{Value: int64(cluster.ExpectedPreset.Worker.ID)}
+
+	k.CurrentNodeCount = types.Int64{Value: int64(cluster.NodeCount.Current.Worker)}
+	k.CurrentControlPlaneNodeCount = types.Int64{Value: int64(cluster.NodeCount.Current.ControlPlane)}
+	k.Status = types.String{Value: cluster.Status.Key}
 }
 
 func (k kubernetesClusterDataSourceData) AppliesTo(cluster kubernetes.Cluster) bool {
@@ -149,6 +157,21 @@ func (k kubernetesClusterDataSourceType) GetSchema(ctx context.Context) (tfsdk.S
 				MarkdownDescription: "unique identifier of the node product",
 				Computed:            true,
 			},
+			"current_node_count": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "number of worker nodes currently running in the cluster",
+				Computed:            true,
+			},
+			"current_control_plane_node_count": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "number of control plane nodes currently running in the cluster",
+				Computed:            true,
+			},
+			"status": {
+				Type:                types.StringType,
+				MarkdownDescription: "status of the cluster (e.g. `healthy` or `working`)",
+				Computed:            true,
+			},
 		},
 	}, nil
 }
@@ -2,11 +2,16 @@ package flow
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+
 	"github.com/flowswiss/goclient/kubernetes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -15,8 +20,14 @@ var (
 )
 
 type kubernetesKubeConfigDataSourceData struct {
-	ClusterID  types.Int64  `tfsdk:"cluster_id"`
-	KubeConfig types.String `tfsdk:"kube_config"`
+	ClusterID            types.Int64  `tfsdk:"cluster_id"`
+	Context              types.String `tfsdk:"context"`
+	KubeConfig           types.String `tfsdk:"kube_config"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	Token                types.String `tfsdk:"token"`
 }
 
 func (k *kubernetesKubeConfigDataSourceData) FromEntity(clusterID int, kubeConfig kubernetes.ClusterKubeConfig) {
@@ -24,6 +35,15 @@ func (k *kubernetesKubeConfigDataSourceData) FromEntity(clusterID int, kubeConfi
 	k.KubeConfig = types.String{Value: kubeConfig.KubeConfig}
 }
 
+func (k *kubernetesKubeConfigDataSourceData) FromCredentials(credentials kubeConfigCredentials) {
+	k.Context = types.String{Value: credentials.Context}
+	k.Host = types.String{Value: credentials.Host}
+	k.ClusterCACertificate = optionalString(credentials.ClusterCACertificate)
+	k.ClientCertificate = optionalString(credentials.ClientCertificate)
+	k.ClientKey = optionalString(credentials.ClientKey)
+	k.Token = optionalString(credentials.Token)
+}
+
 type kubernetesKubeConfigDataSourceType struct{}
 
 func (k kubernetesKubeConfigDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -34,12 +54,48 @@ func (k kubernetesKubeConfigDataSourceType) GetSchema(ctx context.Context) (tfsd
 				MarkdownDescription: "unique identifier of the cluster",
 				Required:            true,
 			},
+			"context": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the context to extract the credentials from (defaults to the current context)",
+				Optional:            true,
+				Computed:            true,
+			},
 			"kube_config": {
 				Type:                types.StringType,
 				MarkdownDescription: "kube config of the cluster",
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"host": {
+				Type:                types.StringType,
+				MarkdownDescription: "address of the kubernetes api server",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"cluster_ca_certificate": {
+				Type:                types.StringType,
+				MarkdownDescription: "PEM encoded certificate authority of the cluster",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"client_certificate": {
+				Type:                types.StringType,
+				MarkdownDescription: "PEM encoded certificate to authenticate the client",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"client_key": {
+				Type:                types.StringType,
+				MarkdownDescription: "PEM encoded private key to authenticate the client",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token": {
+				Type:                types.StringType,
+				MarkdownDescription: "bearer token to authenticate the client",
+				Computed:            true,
+				Sensitive:           true,
+			},
 		},
 	}, nil
 }
@@ -73,9 +129,151 @@ func (k kubernetesKubeConfigDataSource) Read(ctx context.Context, request tfsdk.
 		return
 	}
 
+	credentials, err := parseKubeConfig(kubeConfig.KubeConfig, config.Context.Value)
+	if errors.Is(err, errKubeConfigContextNotFound) {
+		response.Diagnostics.AddAttributeError(path.Root("context"), "Unknown Context", fmt.Sprintf("unable to find context in kube config: %s", err))
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("Invalid Kube Config", fmt.Sprintf("unable to parse kube config: %s", err))
+		return
+	}
+
 	var state kubernetesKubeConfigDataSourceData
 	state.FromEntity(int(config.ClusterID.Value), kubeConfig)
+	state.FromCredentials(credentials)
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
 }
+
+// errKubeConfigContextNotFound is returned by parseKubeConfig if the kube config does not contain the requested context.
+var errKubeConfigContextNotFound = errors.New("context not found")
+
+// kubeConfigCredentials are the credentials required to connect to the cluster of a kube config context.
+type kubeConfigCredentials struct {
+	Context              string
+	Host                 string
+	ClusterCACertificate string
+	ClientCertificate    string
+	ClientKey            string
+	Token                string
+}
+
+type kubeConfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// parseKubeConfig extracts the credentials of the named context from the kube config. The current context of the kube
+// config is used if no context name is given.
+func parseKubeConfig(kubeConfig string, contextName string) (credentials kubeConfigCredentials, err error) {
+	var file kubeConfigFile
+	if err = yaml.Unmarshal([]byte(kubeConfig), &file); err != nil {
+		return
+	}
+
+	if contextName == "" {
+		contextName = file.CurrentContext
+	}
+
+	credentials.Context = contextName
+
+	var clusterName, userName string
+	found := false
+	for _, candidate := range file.Contexts {
+		if candidate.Name == contextName {
+			clusterName, userName = candidate.Context.Cluster, candidate.Context.User
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return credentials, fmt.Errorf("%w: %q", errKubeConfigContextNotFound, contextName)
+	}
+
+	found = false
+	for _, cluster := range file.Clusters {
+		if cluster.Name != clusterName {
+			continue
+		}
+
+		found = true
+		credentials.Host = cluster.Cluster.Server
+		credentials.ClusterCACertificate, err = decodeKubeConfigData(cluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return credentials, fmt.Errorf("invalid certificate authority of cluster %q: %w", clusterName, err)
+		}
+	}
+
+	if !found {
+		return credentials, fmt.Errorf("cluster %q of context %q does not exist", clusterName, contextName)
+	}
+
+	found = false
+	for _, user := range file.Users {
+		if user.Name != userName {
+			continue
+		}
+
+		found = true
+
+		credentials.ClientCertificate, err = decodeKubeConfigData(user.User.ClientCertificateData)
+		if err != nil {
+			return credentials, fmt.Errorf("invalid client certificate of user %q: %w", userName, err)
+		}
+
+		credentials.ClientKey, err = decodeKubeConfigData(user.User.ClientKeyData)
+		if err != nil {
+			return credentials, fmt.Errorf("invalid client key of user %q: %w", userName, err)
+		}
+
+		credentials.Token = user.User.Token
+	}
+
+	if !found {
+		return credentials, fmt.Errorf("user %q of context %q does not exist", userName, contextName)
+	}
+
+	return credentials, nil
+}
+
+func decodeKubeConfigData(data string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+
+	return string(decoded), nil
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: value}
+}
@@ -0,0 +1,108 @@
+package flow
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: production
+  cluster:
+    server: https://production.example.com:6443
+    certificate-authority-data: %[1]s
+- name: staging
+  cluster:
+    server: https://staging.example.com:6443
+    certificate-authority-data: %[1]s
+contexts:
+- name: production-admin
+  context:
+    cluster: production
+    user: production-admin
+- name: staging-deployer
+  context:
+    cluster: staging
+    user: staging-deployer
+- name: removed-admin
+  context:
+    cluster: removed
+    user: production-admin
+- name: revoked-admin
+  context:
+    cluster: production
+    user: revoked-admin
+current-context: production-admin
+users:
+- name: production-admin
+  user:
+    client-certificate-data: %[2]s
+    client-key-data: %[3]s
+- name: staging-deployer
+  user:
+    token: staging-token
+`
+
+func TestParseKubeConfig(t *testing.T) {
+	encode := func(value string) string {
+		return base64.StdEncoding.EncodeToString([]byte(value))
+	}
+
+	kubeConfig := fmt.Sprintf(testKubeConfig, encode("certificate authority"), encode("client certificate"), encode("client key"))
+
+	tests := []struct {
+		context  string
+		expected kubeConfigCredentials
+	}{
+		{
+			context: "",
+			expected: kubeConfigCredentials{
+				Context:              "production-admin",
+				Host:                 "https://production.example.com:6443",
+				ClusterCACertificate: "certificate authority",
+				ClientCertificate:    "client certificate",
+				ClientKey:            "client key",
+			},
+		},
+		{
+			context: "staging-deployer",
+			expected: kubeConfigCredentials{
+				Context:              "staging-deployer",
+				Host:                 "https://staging.example.com:6443",
+				ClusterCACertificate: "certificate authority",
+				Token:                "staging-token",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		credentials, err := parseKubeConfig(kubeConfig, test.context)
+		if err != nil {
+			t.Errorf("parseKubeConfig(%q) returned unexpected error: %s", test.context, err)
+			continue
+		}
+
+		if credentials != test.expected {
+			t.Errorf("parseKubeConfig(%q) = %+v, expected %+v", test.context, credentials, test.expected)
+		}
+	}
+
+	if _, err := parseKubeConfig(kubeConfig, "unknown"); !errors.Is(err, errKubeConfigContextNotFound) {
+		t.Errorf("expected context not found error for unknown context, got %v", err)
+	}
+
+	if _, err := parseKubeConfig(kubeConfig, "removed-admin"); err == nil {
+		t.Error("expected error for context of a missing cluster")
+	}
+
+	if _, err := parseKubeConfig(kubeConfig, "revoked-admin"); err == nil {
+		t.Error("expected error for context of a missing user")
+	}
+
+	if _, err := parseKubeConfig("clusters: [", ""); err == nil || errors.Is(err, errKubeConfigContextNotFound) {
+		t.Errorf("expected parse error for invalid kube config, got %v", err)
+	}
+}
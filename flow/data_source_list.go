@@ -0,0 +1,53 @@
+package flow
+
+import (
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listDataSourceAttributes converts the attributes of a singular data source into the attributes of the elements
+// returned by the corresponding list data source. All attributes become computed as they are not configurable.
+func listDataSourceAttributes(attributes map[string]tfsdk.Attribute) map[string]tfsdk.Attribute {
+	result := make(map[string]tfsdk.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		if attribute.Attributes != nil {
+			nested := listDataSourceAttributes(attribute.Attributes.GetAttributes())
+
+			switch attribute.Attributes.GetNestingMode() {
+			case tfsdk.NestingModeList:
+				attribute.Attributes = tfsdk.ListNestedAttributes(nested)
+			case tfsdk.NestingModeSet:
+				attribute.Attributes = tfsdk.SetNestedAttributes(nested)
+			case tfsdk.NestingModeMap:
+				attribute.Attributes = tfsdk.MapNestedAttributes(nested)
+			default:
+				attribute.Attributes = tfsdk.SingleNestedAttributes(nested)
+			}
+		}
+
+		attribute.Required = false
+		attribute.Optional = false
+		attribute.Computed = true
+		attribute.Validators = nil
+		attribute.PlanModifiers = nil
+
+		result[name] = attribute
+	}
+
+	return result
+}
+
+// listDataSourceID derives the identifier of a list data source from the identifiers of the matching items.
+func listDataSourceID[T any](items []T, id func(T) int) types.String {
+	ids := make([]string, len(items))
+	for idx, item := range items {
+		ids[idx] = strconv.Itoa(id(item))
+	}
+
+	checksum := crc32.ChecksumIEEE([]byte(strings.Join(ids, ",")))
+	return types.String{Value: strconv.FormatUint(uint64(checksum), 10)}
+}
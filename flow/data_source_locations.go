@@ -0,0 +1,113 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*locationsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*locationsDataSource)(nil)
+)
+
+type locationsDataSourceData struct {
+	ID              types.String             `tfsdk:"id"`
+	Name            types.String             `tfsdk:"name"`
+	Key             types.String             `tfsdk:"key"`
+	RequiredModules []moduleDataSourceData   `tfsdk:"required_modules"`
+	Locations       []locationDataSourceData `tfsdk:"locations"`
+}
+
+func (l locationsDataSourceData) AppliesTo(location common.Location) bool {
+	return locationDataSourceData{
+		ID:              types.Int64{Null: true},
+		Name:            l.Name,
+		Key:             l.Key,
+		RequiredModules: l.RequiredModules,
+	}.AppliesTo(location)
+}
+
+type locationsDataSourceType struct{}
+
+func (l locationsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	locationSchema, diagnostics := locationDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching locations",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the location",
+				Optional:            true,
+			},
+			"key": {
+				Type:                types.StringType,
+				MarkdownDescription: "key of the location",
+				Optional:            true,
+			},
+			"required_modules": locationSchema.Attributes["required_modules"],
+			"locations": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(locationSchema.Attributes)),
+				MarkdownDescription: "list of locations matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (l locationsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return locationsDataSource{
+		client: prov.client,
+	}, diagnostics
+}
+
+type locationsDataSource struct {
+	client goclient.Client
+}
+
+func (l locationsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config locationsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := common.NewLocationService(l.client).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list locations: %s", err))
+		return
+	}
+
+	locations := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(locations, func(location common.Location) int { return location.ID })
+	state.Locations = make([]locationDataSourceData, len(locations))
+	for idx, location := range locations {
+		state.Locations[idx].FromEntity(location)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
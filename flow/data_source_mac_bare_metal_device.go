@@ -0,0 +1,191 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalDeviceDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalDeviceDataSource)(nil)
+)
+
+type macBareMetalDeviceNetworkInterfaceDataSourceData struct {
+	ID        types.Int64  `tfsdk:"id"`
+	PrivateIP types.String `tfsdk:"private_ip"`
+	PublicIP  types.String `tfsdk:"public_ip"`
+}
+
+type macBareMetalDeviceDataSourceData struct {
+	ID                types.Int64                                        `tfsdk:"id"`
+	Name              types.String                                       `tfsdk:"name"`
+	LocationID        types.Int64                                        `tfsdk:"location_id"`
+	ProductID         types.Int64                                        `tfsdk:"product_id"`
+	NetworkID         types.Int64                                        `tfsdk:"network_id"`
+	Hostname          types.String                                       `tfsdk:"hostname"`
+	Status            types.String                                       `tfsdk:"status"`
+	NetworkInterfaces []macBareMetalDeviceNetworkInterfaceDataSourceData `tfsdk:"network_interfaces"`
+}
+
+func (m *macBareMetalDeviceDataSourceData) FromEntity(device macbaremetal.Device) {
+	m.ID = types.Int64{Value: int64(device.ID)}
+	m.Name = types.String{Value: device.Name}
+	m.LocationID = types.Int64{Value: int64(device.Location.ID)}
+	m.ProductID = types.Int64{Value: int64(device.Product.ID)}
+	m.NetworkID = types.Int64{Value: int64(device.Network.ID)}
+	m.Hostname = types.String{Value: device.Hostname}
+	m.Status = types.String{Value: device.Status.Key}
+
+	m.NetworkInterfaces = make([]macBareMetalDeviceNetworkInterfaceDataSourceData, len(device.NetworkInterfaces))
+	for idx, networkInterface := range device.NetworkInterfaces {
+		m.NetworkInterfaces[idx] = macBareMetalDeviceNetworkInterfaceDataSourceData{
+			ID:        types.Int64{Value: int64(networkInterface.ID)},
+			PrivateIP: types.String{Value: networkInterface.PrivateIP},
+			PublicIP:  optionalString(networkInterface.PublicIP),
+		}
+	}
+}
+
+func (m macBareMetalDeviceDataSourceData) AppliesTo(device macbaremetal.Device) bool {
+	if !m.ID.Null && m.ID.Value != int64(device.ID) {
+		return false
+	}
+
+	if !m.Name.Null && m.Name.Value != device.Name {
+		return false
+	}
+
+	if !m.LocationID.Null && m.LocationID.Value != int64(device.Location.ID) {
+		return false
+	}
+
+	if !m.ProductID.Null && m.ProductID.Value != int64(device.Product.ID) {
+		return false
+	}
+
+	if !m.NetworkID.Null && m.NetworkID.Value != int64(device.Network.ID) {
+		return false
+	}
+
+	return true
+}
+
+type macBareMetalDeviceDataSourceType struct{}
+
+func (m macBareMetalDeviceDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the device",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the device",
+				Optional:            true,
+				Computed:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the location",
+				Optional:            true,
+				Computed:            true,
+			},
+			"product_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the product",
+				Optional:            true,
+				Computed:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network",
+				Optional:            true,
+				Computed:            true,
+			},
+			"hostname": {
+				Type:                types.StringType,
+				MarkdownDescription: "hostname of the device",
+				Computed:            true,
+			},
+			"status": {
+				Type:                types.StringType,
+				MarkdownDescription: "status of the device (e.g. `running` or `stopped`)",
+				Computed:            true,
+			},
+			"network_interfaces": {
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:                types.Int64Type,
+						MarkdownDescription: "unique identifier of the network interface",
+						Computed:            true,
+					},
+					"private_ip": {
+						Type:                types.StringType,
+						MarkdownDescription: "private ip of the network interface",
+						Computed:            true,
+					},
+					"public_ip": {
+						Type:                types.StringType,
+						MarkdownDescription: "public ip of the network interface if an elastic ip is attached",
+						Computed:            true,
+					},
+				}),
+				MarkdownDescription: "network interfaces of the device",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (m macBareMetalDeviceDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalDeviceDataSource{
+		deviceService: macbaremetal.NewDeviceService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalDeviceDataSource struct {
+	deviceService macbaremetal.DeviceService
+}
+
+func (m macBareMetalDeviceDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalDeviceDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := m.deviceService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list devices: %s", err))
+		return
+	}
+
+	device, err := filter.FindOne(config, list.Items)
+	if err != nil {
+		response.Diagnostics.AddError("Not Found", fmt.Sprintf("unable to find device: %s", err))
+		return
+	}
+
+	var state macBareMetalDeviceDataSourceData
+	state.FromEntity(device)
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
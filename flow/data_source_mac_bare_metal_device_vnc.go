@@ -0,0 +1,128 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalDeviceVNCDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalDeviceVNCDataSource)(nil)
+)
+
+// defaultConsolePorts are the ports used by the schemes of console urls which do not contain a port.
+var defaultConsolePorts = map[string]int{
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+	"vnc":   5900,
+}
+
+type macBareMetalDeviceVNCDataSourceData struct {
+	DeviceID types.Int64  `tfsdk:"device_id"`
+	URL      types.String `tfsdk:"url"`
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+}
+
+func (m *macBareMetalDeviceVNCDataSourceData) FromEntity(deviceID int, vnc macbaremetal.DeviceVNCConnection) {
+	m.DeviceID = types.Int64{Value: int64(deviceID)}
+	m.URL = types.String{Value: vnc.Ref}
+	m.Host = types.String{Null: true}
+	m.Port = types.Int64{Null: true}
+
+	// the api only returns the url of the console, its host and port are taken from the url if it can be parsed
+	consoleURL, err := url.Parse(vnc.Ref)
+	if err != nil || consoleURL.Hostname() == "" {
+		return
+	}
+
+	m.Host = types.String{Value: consoleURL.Hostname()}
+
+	if port, err := strconv.Atoi(consoleURL.Port()); err == nil {
+		m.Port = types.Int64{Value: int64(port)}
+	} else if port, ok := defaultConsolePorts[consoleURL.Scheme]; ok {
+		m.Port = types.Int64{Value: int64(port)}
+	}
+}
+
+type macBareMetalDeviceVNCDataSourceType struct{}
+
+func (m macBareMetalDeviceVNCDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "looks up the remote console of a mac bare metal device. The api only returns the url of a console, whose host and port are exposed separately. The api does not return any credentials, the console url itself grants access to the device.",
+		Attributes: map[string]tfsdk.Attribute{
+			"device_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the device",
+				Required:            true,
+			},
+			"url": {
+				Type:                types.StringType,
+				MarkdownDescription: "url of the console of the device, which is the only connection detail returned by the api",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"host": {
+				Type:                types.StringType,
+				MarkdownDescription: "host of the console, taken from its url",
+				Computed:            true,
+			},
+			"port": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "port of the console, taken from its url or the default port of its scheme",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (m macBareMetalDeviceVNCDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalDeviceVNCDataSource{
+		deviceService: macbaremetal.NewDeviceService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalDeviceVNCDataSource struct {
+	deviceService macbaremetal.DeviceService
+}
+
+func (m macBareMetalDeviceVNCDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalDeviceVNCDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	vnc, err := m.deviceService.GetVNC(ctx, int(config.DeviceID.Value))
+	if isNotFoundError(err) {
+		response.Diagnostics.AddAttributeError(path.Root("device_id"), "Not Found", fmt.Sprintf("device %d not found", config.DeviceID.Value))
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get vnc connection: %s", err))
+		return
+	}
+
+	var state macBareMetalDeviceVNCDataSourceData
+	state.FromEntity(int(config.DeviceID.Value), vnc)
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
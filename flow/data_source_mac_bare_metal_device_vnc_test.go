@@ -0,0 +1,98 @@
+package flow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMacBareMetalDeviceVNCDataSource_Basic(t *testing.T) {
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceVNCDataSourceConfigBasic, deviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.flow_mac_bare_metal_device_vnc.foobar", "device_id", "flow_mac_bare_metal_device.foobar", "id"),
+					resource.TestCheckResourceAttrSet("data.flow_mac_bare_metal_device_vnc.foobar", "url"),
+					resource.TestCheckResourceAttrSet("data.flow_mac_bare_metal_device_vnc.foobar", "host"),
+					resource.TestCheckResourceAttrSet("data.flow_mac_bare_metal_device_vnc.foobar", "port"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMacBareMetalDeviceVNCDataSourceConfigBasic = `
+data "flow_mac_bare_metal_network" "foobar" {
+	name = "default"
+}
+
+resource "flow_mac_bare_metal_device" "foobar" {
+	name       = "%s"
+	location   = "ZRH1"
+	product    = "Mac mini M1"
+	network_id = data.flow_mac_bare_metal_network.foobar.id
+	password   = "Sup3rS3cr3t!"
+}
+
+data "flow_mac_bare_metal_device_vnc" "foobar" {
+	device_id = flow_mac_bare_metal_device.foobar.id
+}
+`
+
+func TestAccMacBareMetalDeviceVNCDataSource_NotFound(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMacBareMetalDeviceVNCDataSourceConfigNotFound,
+				ExpectError: regexp.MustCompile("device 999999 not found"),
+			},
+		},
+	})
+}
+
+const testAccMacBareMetalDeviceVNCDataSourceConfigNotFound = `
+data "flow_mac_bare_metal_device_vnc" "foobar" {
+	device_id = 999999
+}
+`
+
+func TestMacBareMetalDeviceVNCDataSourceData_FromEntity(t *testing.T) {
+	tests := []struct {
+		ref  string
+		host string
+		port int64
+	}{
+		{ref: "https://vnc.example.com/devices/1?token=secret", host: "vnc.example.com", port: 443},
+		{ref: "http://vnc.example.com/devices/1", host: "vnc.example.com", port: 80},
+		{ref: "wss://vnc.example.com:8443/websockify", host: "vnc.example.com", port: 8443},
+		{ref: "vnc://[2001:db8::1]", host: "2001:db8::1", port: 5900},
+		{ref: "custom://vnc.example.com", host: "vnc.example.com", port: 0},
+		{ref: "not a url", host: "", port: 0},
+	}
+
+	for _, test := range tests {
+		var data macBareMetalDeviceVNCDataSourceData
+		data.FromEntity(1, macbaremetal.DeviceVNCConnection{Ref: test.ref})
+
+		if data.URL.Value != test.ref {
+			t.Errorf("FromEntity(%q) set url %q", test.ref, data.URL.Value)
+		}
+
+		if data.Host.Null != (test.host == "") || data.Host.Value != test.host {
+			t.Errorf("FromEntity(%q) set host %v, expected %q", test.ref, data.Host, test.host)
+		}
+
+		if data.Port.Null != (test.port == 0) || data.Port.Value != test.port {
+			t.Errorf("FromEntity(%q) set port %v, expected %d", test.ref, data.Port, test.port)
+		}
+	}
+}
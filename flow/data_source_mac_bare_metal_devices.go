@@ -0,0 +1,124 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalDevicesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalDevicesDataSource)(nil)
+)
+
+type macBareMetalDevicesDataSourceData struct {
+	ID         types.String                       `tfsdk:"id"`
+	Name       types.String                       `tfsdk:"name"`
+	LocationID types.Int64                        `tfsdk:"location_id"`
+	ProductID  types.Int64                        `tfsdk:"product_id"`
+	NetworkID  types.Int64                        `tfsdk:"network_id"`
+	Devices    []macBareMetalDeviceDataSourceData `tfsdk:"devices"`
+}
+
+func (m macBareMetalDevicesDataSourceData) AppliesTo(device macbaremetal.Device) bool {
+	return macBareMetalDeviceDataSourceData{
+		ID:         types.Int64{Null: true},
+		Name:       m.Name,
+		LocationID: m.LocationID,
+		ProductID:  m.ProductID,
+		NetworkID:  m.NetworkID,
+	}.AppliesTo(device)
+}
+
+type macBareMetalDevicesDataSourceType struct{}
+
+func (m macBareMetalDevicesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	deviceSchema, diagnostics := macBareMetalDeviceDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching devices",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the device",
+				Optional:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the location",
+				Optional:            true,
+			},
+			"product_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the product",
+				Optional:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network",
+				Optional:            true,
+			},
+			"devices": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(deviceSchema.Attributes)),
+				MarkdownDescription: "list of devices matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (m macBareMetalDevicesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalDevicesDataSource{
+		deviceService: macbaremetal.NewDeviceService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalDevicesDataSource struct {
+	deviceService macbaremetal.DeviceService
+}
+
+func (m macBareMetalDevicesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalDevicesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := m.deviceService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list devices: %s", err))
+		return
+	}
+
+	devices := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(devices, func(device macbaremetal.Device) int { return device.ID })
+	state.Devices = make([]macBareMetalDeviceDataSourceData, len(devices))
+	for idx, device := range devices {
+		state.Devices[idx].FromEntity(device)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
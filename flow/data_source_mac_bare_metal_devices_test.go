@@ -0,0 +1,57 @@
+package flow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMacBareMetalDevicesDataSource_Basic(t *testing.T) {
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDevicesDataSourceConfigBasic, deviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.flow_mac_bare_metal_device.foobar", "id", "flow_mac_bare_metal_device.foobar", "id"),
+					resource.TestCheckResourceAttr("data.flow_mac_bare_metal_device.foobar", "location_id", "2"),
+					resource.TestCheckResourceAttr("data.flow_mac_bare_metal_device.foobar", "product_id", "50"),
+					resource.TestCheckResourceAttr("data.flow_mac_bare_metal_device.foobar", "status", "running"),
+					resource.TestCheckResourceAttr("data.flow_mac_bare_metal_device.foobar", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_mac_bare_metal_device.foobar", "network_interfaces.0.id", "flow_mac_bare_metal_device.foobar", "network_interface_id"),
+					resource.TestCheckResourceAttrSet("data.flow_mac_bare_metal_device.foobar", "network_interfaces.0.private_ip"),
+					resource.TestCheckResourceAttr("data.flow_mac_bare_metal_devices.foobar", "devices.#", "1"),
+					resource.TestCheckResourceAttrPair("data.flow_mac_bare_metal_devices.foobar", "devices.0.id", "flow_mac_bare_metal_device.foobar", "id"),
+					resource.TestCheckResourceAttr("data.flow_mac_bare_metal_devices.foobar", "devices.0.name", deviceName),
+				),
+			},
+		},
+	})
+}
+
+const testAccMacBareMetalDevicesDataSourceConfigBasic = `
+data "flow_mac_bare_metal_network" "foobar" {
+	name = "default"
+}
+
+resource "flow_mac_bare_metal_device" "foobar" {
+	name       = "%s"
+	location   = "ZRH1"
+	product    = "Mac mini M1"
+	network_id = data.flow_mac_bare_metal_network.foobar.id
+	password   = "Sup3rS3cr3t!"
+}
+
+data "flow_mac_bare_metal_device" "foobar" {
+	name = flow_mac_bare_metal_device.foobar.name
+}
+
+data "flow_mac_bare_metal_devices" "foobar" {
+	name        = flow_mac_bare_metal_device.foobar.name
+	location_id = 2
+}
+`
@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalElasticIPsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalElasticIPsDataSource)(nil)
+)
+
+type macBareMetalElasticIPsDataSourceData struct {
+	ID         types.String                          `tfsdk:"id"`
+	LocationID types.Int64                           `tfsdk:"location_id"`
+	PublicIP   types.String                          `tfsdk:"public_ip"`
+	ElasticIPs []macBareMetalElasticIPDataSourceData `tfsdk:"elastic_ips"`
+}
+
+func (c macBareMetalElasticIPsDataSourceData) AppliesTo(elasticIP macbaremetal.ElasticIP) bool {
+	return macBareMetalElasticIPDataSourceData{
+		ID:         types.Int64{Null: true},
+		LocationID: c.LocationID,
+		PublicIP:   c.PublicIP,
+	}.AppliesTo(elasticIP)
+}
+
+type macBareMetalElasticIPsDataSourceType struct{}
+
+func (c macBareMetalElasticIPsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	elasticIPSchema, diagnostics := macBareMetalElasticIPDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching elastic ips",
+				Computed:            true,
+			},
+			"location_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "location of the elastic ip",
+				Optional:            true,
+			},
+			"public_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "public ip address",
+				Optional:            true,
+			},
+			"elastic_ips": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(elasticIPSchema.Attributes)),
+				MarkdownDescription: "list of elastic ips matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c macBareMetalElasticIPsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalElasticIPsDataSource{
+		elasticIPService: macbaremetal.NewElasticIPService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalElasticIPsDataSource struct {
+	elasticIPService macbaremetal.ElasticIPService
+}
+
+func (c macBareMetalElasticIPsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalElasticIPsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.elasticIPService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list elastic ips: %s", err))
+		return
+	}
+
+	elasticIPs := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(elasticIPs, func(elasticIP macbaremetal.ElasticIP) int { return elasticIP.ID })
+	state.ElasticIPs = make([]macBareMetalElasticIPDataSourceData, len(elasticIPs))
+	for idx, elasticIP := range elasticIPs {
+		state.ElasticIPs[idx].FromEntity(elasticIP)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
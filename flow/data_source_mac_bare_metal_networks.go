@@ -0,0 +1,103 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalNetworksDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalNetworksDataSource)(nil)
+)
+
+type macBareMetalNetworksDataSourceData struct {
+	ID       types.String                        `tfsdk:"id"`
+	Name     types.String                        `tfsdk:"name"`
+	Networks []macBareMetalNetworkDataSourceData `tfsdk:"networks"`
+}
+
+func (c macBareMetalNetworksDataSourceData) AppliesTo(network macbaremetal.Network) bool {
+	return macBareMetalNetworkDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: c.Name,
+	}.AppliesTo(network)
+}
+
+type macBareMetalNetworksDataSourceType struct{}
+
+func (c macBareMetalNetworksDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	networkSchema, diagnostics := macBareMetalNetworkDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching networks",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the network",
+				Optional:            true,
+			},
+			"networks": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(networkSchema.Attributes)),
+				MarkdownDescription: "list of networks matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c macBareMetalNetworksDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalNetworksDataSource{
+		networkService: macbaremetal.NewNetworkService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalNetworksDataSource struct {
+	networkService macbaremetal.NetworkService
+}
+
+func (c macBareMetalNetworksDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalNetworksDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.networkService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list networks: %s", err))
+		return
+	}
+
+	networks := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(networks, func(network macbaremetal.Network) int { return network.ID })
+	state.Networks = make([]macBareMetalNetworkDataSourceData, len(networks))
+	for idx, network := range networks {
+		state.Networks[idx].FromEntity(network)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
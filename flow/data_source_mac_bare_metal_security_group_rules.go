@@ -0,0 +1,96 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalSecurityGroupRulesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalSecurityGroupRulesDataSource)(nil)
+)
+
+type macBareMetalSecurityGroupRulesDataSourceData struct {
+	ID              types.String                                  `tfsdk:"id"`
+	SecurityGroupID types.Int64                                   `tfsdk:"security_group_id"`
+	Rules           []macBareMetalSecurityGroupRuleDataSourceData `tfsdk:"rules"`
+}
+
+type macBareMetalSecurityGroupRulesDataSourceType struct{}
+
+func (c macBareMetalSecurityGroupRulesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	ruleSchema, diagnostics := macBareMetalSecurityGroupRuleDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching security group rules",
+				Computed:            true,
+			},
+			"security_group_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the security group",
+				Required:            true,
+			},
+			"rules": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(ruleSchema.Attributes)),
+				MarkdownDescription: "list of security group rules matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c macBareMetalSecurityGroupRulesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalSecurityGroupRulesDataSource{
+		securityGroupService: macbaremetal.NewSecurityGroupService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalSecurityGroupRulesDataSource struct {
+	securityGroupService macbaremetal.SecurityGroupService
+}
+
+func (c macBareMetalSecurityGroupRulesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalSecurityGroupRulesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := int(config.SecurityGroupID.Value)
+
+	list, err := c.securityGroupService.Rules(securityGroupID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list security group rules: %s", err))
+		return
+	}
+
+	rules := list.Items
+
+	state := config
+	state.ID = listDataSourceID(rules, func(rule macbaremetal.SecurityGroupRule) int { return rule.ID })
+	state.Rules = make([]macBareMetalSecurityGroupRuleDataSourceData, len(rules))
+	for idx, rule := range rules {
+		state.Rules[idx].FromEntity(securityGroupID, rule)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*macBareMetalSecurityGroupsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*macBareMetalSecurityGroupsDataSource)(nil)
+)
+
+type macBareMetalSecurityGroupsDataSourceData struct {
+	ID             types.String                              `tfsdk:"id"`
+	Name           types.String                              `tfsdk:"name"`
+	NetworkID      types.Int64                               `tfsdk:"network_id"`
+	SecurityGroups []macBareMetalSecurityGroupDataSourceData `tfsdk:"security_groups"`
+}
+
+func (c macBareMetalSecurityGroupsDataSourceData) AppliesTo(securityGroup macbaremetal.SecurityGroup) bool {
+	return macBareMetalSecurityGroupDataSourceData{
+		ID:        types.Int64{Null: true},
+		Name:      c.Name,
+		NetworkID: c.NetworkID,
+	}.AppliesTo(securityGroup)
+}
+
+type macBareMetalSecurityGroupsDataSourceType struct{}
+
+func (c macBareMetalSecurityGroupsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	securityGroupSchema, diagnostics := macBareMetalSecurityGroupDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching security groups",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the security group",
+				Optional:            true,
+			},
+			"network_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the network",
+				Optional:            true,
+			},
+			"security_groups": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(securityGroupSchema.Attributes)),
+				MarkdownDescription: "list of security groups matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (c macBareMetalSecurityGroupsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return macBareMetalSecurityGroupsDataSource{
+		securityGroupService: macbaremetal.NewSecurityGroupService(prov.client),
+	}, diagnostics
+}
+
+type macBareMetalSecurityGroupsDataSource struct {
+	securityGroupService macbaremetal.SecurityGroupService
+}
+
+func (c macBareMetalSecurityGroupsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config macBareMetalSecurityGroupsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.securityGroupService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list security groups: %s", err))
+		return
+	}
+
+	securityGroups := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(securityGroups, func(securityGroup macbaremetal.SecurityGroup) int { return securityGroup.ID })
+	state.SecurityGroups = make([]macBareMetalSecurityGroupDataSourceData, len(securityGroups))
+	for idx, securityGroup := range securityGroups {
+		state.SecurityGroups[idx].FromEntity(securityGroup)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,103 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*modulesDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*modulesDataSource)(nil)
+)
+
+type modulesDataSourceData struct {
+	ID      types.String           `tfsdk:"id"`
+	Name    types.String           `tfsdk:"name"`
+	Modules []moduleDataSourceData `tfsdk:"modules"`
+}
+
+func (m modulesDataSourceData) AppliesTo(module common.Module) bool {
+	return moduleDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: m.Name,
+	}.AppliesTo(module)
+}
+
+type modulesDataSourceType struct{}
+
+func (m modulesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	moduleSchema, diagnostics := moduleDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching modules",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the module",
+				Optional:            true,
+			},
+			"modules": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(moduleSchema.Attributes)),
+				MarkdownDescription: "list of modules matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (m modulesDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return modulesDataSource{
+		client: prov.client,
+	}, diagnostics
+}
+
+type modulesDataSource struct {
+	client goclient.Client
+}
+
+func (m modulesDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config modulesDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := common.NewModuleService(m.client).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list modules: %s", err))
+		return
+	}
+
+	modules := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(modules, func(module common.Module) int { return module.ID })
+	state.Modules = make([]moduleDataSourceData, len(modules))
+	for idx, module := range modules {
+		state.Modules[idx].FromEntity(module)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
@@ -0,0 +1,110 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+var (
+	_ tfsdk.DataSourceType = (*productsDataSourceType)(nil)
+	_ tfsdk.DataSource     = (*productsDataSource)(nil)
+)
+
+type productsDataSourceData struct {
+	ID       types.String            `tfsdk:"id"`
+	Name     types.String            `tfsdk:"name"`
+	Type     types.String            `tfsdk:"type"`
+	Products []productDataSourceData `tfsdk:"products"`
+}
+
+func (p productsDataSourceData) AppliesTo(product common.Product) bool {
+	return productDataSourceData{
+		ID:   types.Int64{Null: true},
+		Name: p.Name,
+		Type: p.Type,
+	}.AppliesTo(product)
+}
+
+type productsDataSourceType struct{}
+
+func (productsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	productSchema, diagnostics := productDataSourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		return tfsdk.Schema{}, diagnostics
+	}
+
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				MarkdownDescription: "identifier of the list, derived from the matching products",
+				Computed:            true,
+			},
+			"name": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the product",
+				Optional:            true,
+			},
+			"type": {
+				Type:                types.StringType,
+				MarkdownDescription: "type of the product",
+				Optional:            true,
+			},
+			"products": {
+				Attributes:          tfsdk.ListNestedAttributes(listDataSourceAttributes(productSchema.Attributes)),
+				MarkdownDescription: "list of products matching the filter",
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (productsDataSourceType) NewDataSource(ctx context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	prov, diagnostics := convertToLocalProviderType(p)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return productsDataSource{
+		productService: common.NewProductService(prov.client),
+	}, diagnostics
+}
+
+type productsDataSource struct {
+	productService common.ProductService
+}
+
+func (p productsDataSource) Read(ctx context.Context, request tfsdk.ReadDataSourceRequest, response *tfsdk.ReadDataSourceResponse) {
+	var config productsDataSourceData
+	diagnostics := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := p.productService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list products: %s", err))
+		return
+	}
+
+	products := filter.Find(config, list.Items)
+
+	state := config
+	state.ID = listDataSourceID(products, func(product common.Product) int { return product.ID })
+	state.Products = make([]productDataSourceData, len(products))
+	for idx, product := range products {
+		state.Products[idx].FromEntity(product)
+	}
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
+}
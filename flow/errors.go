@@ -0,0 +1,41 @@
+package flow
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/flowswiss/goclient"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+// isNotFoundError reports whether err indicates that the requested entity does not exist on the platform. This is
+// either the case if the api responded with a 404 status code or if the entity was missing in a listing.
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, filter.ErrNoResults) {
+		return true
+	}
+
+	var apiError goclient.APIError
+	if errors.As(err, &apiError) && apiError.Response() != nil {
+		return apiError.Response().StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+// isConflictError reports whether err indicates that the request conflicts with the current state of the entity on the
+// platform, for example because it is still in use by another entity. Such conflicts are usually temporary and resolve
+// themselves once the other entity has been released.
+func isConflictError(err error) bool {
+	var apiError goclient.APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+
+	return apiError.Response() != nil && apiError.Response().StatusCode == http.StatusConflict
+}
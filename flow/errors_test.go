@@ -0,0 +1,91 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	ctx := context.Background()
+
+	_, notFound := compute.NewServerService(newFakeAPIClient(t, fakeAPIToken)).Get(ctx, 42)
+	_, unauthorized := compute.NewServerService(newFakeAPIClient(t, "invalid")).Get(ctx, 42)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "not found status", err: notFound, expected: true},
+		{name: "other status", err: unauthorized, expected: false},
+		{name: "wrapped not found status", err: fmt.Errorf("unable to get server: %w", notFound), expected: true},
+		{name: "no results", err: filter.ErrNoResults, expected: true},
+		{name: "wrapped no results", err: fmt.Errorf("unable to find server: %w", filter.ErrNoResults), expected: true},
+		{name: "other error", err: errors.New("connection refused"), expected: false},
+	}
+
+	for _, test := range tests {
+		if actual := isNotFoundError(test.err); actual != test.expected {
+			t.Errorf("isNotFoundError(%s) = %t, expected %t (error: %v)", test.name, actual, test.expected, test.err)
+		}
+	}
+}
+
+func TestIsConflictError(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeAPIClient(t, fakeAPIToken)
+
+	networkService := compute.NewNetworkService(client)
+	network, err := networkService.Create(ctx, compute.NetworkCreate{Name: "test-network", LocationID: 1, CIDR: "10.0.0.0/24"})
+	if err != nil {
+		t.Fatalf("unable to create network: %s", err)
+	}
+
+	router, err := compute.NewRouterService(client).Create(ctx, compute.RouterCreate{Name: "test-router", LocationID: 1})
+	if err != nil {
+		t.Fatalf("unable to create router: %s", err)
+	}
+
+	_, err = compute.NewRouterInterfaceService(client, router.ID).Create(ctx, compute.RouterInterfaceCreate{NetworkID: network.ID})
+	if err != nil {
+		t.Fatalf("unable to create router interface: %s", err)
+	}
+
+	conflict := networkService.Delete(ctx, network.ID)
+
+	// only the status code is relevant, even if the message of another error mentions that an entity is in use
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeFakeResponse(w, nil, fakeError{status: http.StatusBadRequest, message: "network is still in use"})
+	}))
+	t.Cleanup(server.Close)
+
+	badRequest := goclient.NewClient(goclient.WithToken(fakeAPIToken), goclient.WithBase(server.URL+"/")).Get(ctx, "/v4/compute/networks/1", nil)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "conflict status", err: conflict, expected: true},
+		{name: "wrapped conflict status", err: fmt.Errorf("unable to delete network: %w", conflict), expected: true},
+		{name: "other status mentioning in use", err: badRequest, expected: false},
+		{name: "other error", err: errors.New("network is still in use"), expected: false},
+	}
+
+	for _, test := range tests {
+		if actual := isConflictError(test.err); actual != test.expected {
+			t.Errorf("isConflictError(%s) = %t, expected %t (error: %v)", test.name, actual, test.expected, test.err)
+		}
+	}
+}
@@ -0,0 +1,1878 @@
+package flow
+
+import (
+	"crypto/md5"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/compute"
+)
+
+func fakeServerStatus(id int) compute.ServerStatus {
+	statuses := map[int]compute.ServerStatus{
+		compute.ServerStatusRunning:   {ID: compute.ServerStatusRunning, Name: "Running", Key: "running"},
+		compute.ServerStatusStopped:   {ID: compute.ServerStatusStopped, Name: "Stopped", Key: "stopped"},
+		compute.ServerStatusStarting:  {ID: compute.ServerStatusStarting, Name: "Starting", Key: "starting"},
+		compute.ServerStatusStopping:  {ID: compute.ServerStatusStopping, Name: "Stopping", Key: "stopping"},
+		compute.ServerStatusError:     {ID: compute.ServerStatusError, Name: "Error", Key: "error"},
+		compute.ServerStatusUpgrading: {ID: compute.ServerStatusUpgrading, Name: "Upgrading", Key: "upgrading"},
+	}
+
+	return statuses[id]
+}
+
+func fakeVolumeStatus(id int) compute.VolumeStatus {
+	statuses := map[int]compute.VolumeStatus{
+		compute.VolumeStatusAvailable: {ID: compute.VolumeStatusAvailable, Name: "Available", Key: "available"},
+		compute.VolumeStatusInUse:     {ID: compute.VolumeStatusInUse, Name: "In Use", Key: "in-use"},
+		compute.VolumeStatusWorking:   {ID: compute.VolumeStatusWorking, Name: "Working", Key: "working"},
+	}
+
+	return statuses[id]
+}
+
+func fakeSnapshotStatus(id int) compute.SnapshotStatus {
+	statuses := map[int]compute.SnapshotStatus{
+		compute.SnapshotStatusAvailable: {ID: compute.SnapshotStatusAvailable, Name: "Available", Key: "available"},
+		compute.SnapshotStatusCreating:  {ID: compute.SnapshotStatusCreating, Name: "Creating", Key: "creating"},
+	}
+
+	return statuses[id]
+}
+
+func fakeLoadBalancerStatus(id int) compute.LoadBalancerStatus {
+	statuses := map[int]compute.LoadBalancerStatus{
+		compute.LoadBalancerStatusActive:  {ID: compute.LoadBalancerStatusActive, Name: "Active", Key: "active"},
+		compute.LoadBalancerStatusWorking: {ID: compute.LoadBalancerStatusWorking, Name: "Working", Key: "working"},
+	}
+
+	return statuses[id]
+}
+
+func (f *fakeAPI) seedCompute() {
+	f.images = []compute.Image{
+		{ID: 1, OperatingSystem: "Ubuntu", Version: "22.04 LTS", Key: "linux-ubuntu-22.04-lts", Category: "linux", Type: "distribution", Username: "ubuntu", MinRootDiskSize: 10, Sorting: 1, AvailableLocations: []int{1}},
+		{ID: 2, OperatingSystem: "Ubuntu", Version: "20.04 LTS", Key: "linux-ubuntu-20.04-lts", Category: "linux", Type: "distribution", Username: "ubuntu", MinRootDiskSize: 10, Sorting: 2, AvailableLocations: []int{1}},
+		{ID: 3, OperatingSystem: "Debian", Version: "11", Key: "linux-debian-11", Category: "linux", Type: "distribution", Username: "debian", MinRootDiskSize: 10, Sorting: 3, AvailableLocations: []int{1}},
+		{ID: 10, OperatingSystem: "Windows Server", Version: "2022", Key: "windows-server-2022", Category: "windows", Type: "distribution", Username: "Administrator", MinRootDiskSize: 50, Sorting: 10, AvailableLocations: []int{1}},
+	}
+
+	f.loadBalancerAlgorithms = []compute.LoadBalancerAlgorithm{
+		{ID: 1, Name: "Round Robin", Key: "round_robin"},
+		{ID: 2, Name: "Least Connections", Key: "least_connections"},
+		{ID: 3, Name: "Source IP", Key: "source_ip"},
+	}
+
+	f.loadBalancerProtocols = []compute.LoadBalancerProtocol{
+		{ID: 1, Name: "HTTP", Key: "http"},
+		{ID: 2, Name: "HTTPS", Key: "https"},
+		{ID: 3, Name: "TCP", Key: "tcp"},
+	}
+
+	f.loadBalancerHealthChecks = []compute.LoadBalancerHealthCheckType{
+		{ID: 1, Name: "HTTP", Key: "http"},
+		{ID: 2, Name: "HTTPS", Key: "https"},
+		{ID: 3, Name: "TCP", Key: "tcp"},
+		{ID: 4, Name: "Ping", Key: "ping"},
+	}
+
+	location, _ := f.location(1)
+
+	subnet, _ := parseFakeSubnet("172.31.0.0/24")
+	network := compute.Network{
+		ID:                  f.nextID(),
+		Name:                "default",
+		CIDR:                "172.31.0.0/24",
+		Location:            location,
+		DomainNameServers:   []string{"1.1.1.1", "8.8.8.8"},
+		AllocationPoolStart: subnet.start,
+		AllocationPoolEnd:   subnet.end,
+		GatewayIP:           subnet.gateway,
+		TotalIPs:            subnet.total,
+	}
+	f.computeNetworks.Put(network.ID, network)
+
+	securityGroup := compute.SecurityGroup{
+		ID:          f.nextID(),
+		Name:        "default",
+		Description: "default security group",
+		Location:    location,
+		Default:     true,
+		Immutable:   true,
+	}
+	f.computeSecurityGroups.Put(securityGroup.ID, securityGroup)
+}
+
+func (f *fakeAPI) registerComputeRoutes() {
+	f.registerComputeEntityRoutes()
+	f.registerComputeNetworkRoutes()
+	f.registerComputeKeyPairRoutes()
+	f.registerComputeCertificateRoutes()
+	f.registerComputeElasticIPRoutes()
+	f.registerComputeRouterRoutes()
+	f.registerComputeSecurityGroupRoutes()
+	f.registerComputeServerRoutes()
+	f.registerComputeVolumeRoutes()
+	f.registerComputeSnapshotRoutes()
+	f.registerComputeLoadBalancerRoutes()
+}
+
+func (f *fakeAPI) registerComputeEntityRoutes() {
+	f.handle(http.MethodGet, "/v4/entities/compute/images", func(r fakeRequest) (interface{}, error) {
+		return f.images, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/entities/compute/images/{}", func(r fakeRequest) (interface{}, error) {
+		return f.image(r.param(0))
+	})
+
+	f.handle(http.MethodGet, "/v4/entities/compute/load-balancer-algorithms", func(r fakeRequest) (interface{}, error) {
+		return f.loadBalancerAlgorithms, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/entities/compute/load-balancer-protocols", func(r fakeRequest) (interface{}, error) {
+		return f.loadBalancerProtocols, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/entities/compute/load-balancer-health-check-types", func(r fakeRequest) (interface{}, error) {
+		return f.loadBalancerHealthChecks, nil
+	})
+}
+
+func (f *fakeAPI) image(id int) (compute.Image, error) {
+	for _, image := range f.images {
+		if image.ID == id {
+			return image, nil
+		}
+	}
+
+	return compute.Image{}, fakeNotFound("image", id)
+}
+
+// fakeProductShrinks reports whether any item of the target product provides less than the item of the current product.
+func fakeProductShrinks(current, target common.Product) bool {
+	for _, item := range current.Items {
+		for _, targetItem := range target.Items {
+			if targetItem.ID == item.ID && targetItem.Amount < item.Amount {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (f *fakeAPI) registerComputeNetworkRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/networks", func(r fakeRequest) (interface{}, error) {
+		return f.computeNetworks.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/networks/{}", func(r fakeRequest) (interface{}, error) {
+		return f.computeNetworks.Find("network", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/networks", func(r fakeRequest) (interface{}, error) {
+		var body compute.NetworkCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		subnet, err := parseFakeSubnet(body.CIDR)
+		if err != nil {
+			return nil, err
+		}
+
+		network := compute.Network{
+			ID:                  f.nextID(),
+			Name:                body.Name,
+			Description:         body.Description,
+			CIDR:                body.CIDR,
+			Location:            location,
+			DomainNameServers:   body.DomainNameServers,
+			AllocationPoolStart: fakeDefault(body.AllocationPoolStart, subnet.start),
+			AllocationPoolEnd:   fakeDefault(body.AllocationPoolEnd, subnet.end),
+			GatewayIP:           fakeDefault(body.GatewayIP, subnet.gateway),
+			TotalIPs:            subnet.total,
+		}
+
+		f.computeNetworks.Put(network.ID, network)
+		return network, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/networks/{}", func(r fakeRequest) (interface{}, error) {
+		network, err := f.computeNetworks.Find("network", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.NetworkUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		network.Name = fakeDefault(body.Name, network.Name)
+		network.Description = fakeDefault(body.Description, network.Description)
+		network.AllocationPoolStart = fakeDefault(body.AllocationPoolStart, network.AllocationPoolStart)
+		network.AllocationPoolEnd = fakeDefault(body.AllocationPoolEnd, network.AllocationPoolEnd)
+		network.GatewayIP = fakeDefault(body.GatewayIP, network.GatewayIP)
+
+		if body.DomainNameServers != nil {
+			network.DomainNameServers = body.DomainNameServers
+		}
+
+		f.computeNetworks.Put(network.ID, network)
+		return network, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/networks/{}", func(r fakeRequest) (interface{}, error) {
+		network, err := f.computeNetworks.Find("network", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if f.computeNetworkInUse(network.ID) {
+			return nil, fakeConflict("network %d is still in use", network.ID)
+		}
+
+		f.computeNetworks.Delete(network.ID)
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) computeNetworkInUse(id int) bool {
+	for _, interfaces := range f.computeNetworkInterfaces {
+		for _, networkInterface := range interfaces.List() {
+			if networkInterface.Network.ID == id {
+				return true
+			}
+		}
+	}
+
+	for _, interfaces := range f.computeRouterInterfaces {
+		for _, routerInterface := range interfaces.List() {
+			if routerInterface.Network.ID == id {
+				return true
+			}
+		}
+	}
+
+	for _, loadBalancer := range f.computeLoadBalancers.List() {
+		for _, network := range loadBalancer.Networks {
+			if network.ID == id {
+				return true
+			}
+		}
+	}
+
+	for _, cluster := range f.kubernetesClusters.List() {
+		if cluster.Network.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allocateComputeIP reserves a private ip in the given network. Addresses are never reused, which is sufficient for
+// the short-lived test fixtures.
+func (f *fakeAPI) allocateComputeIP(networkID int, requested string) (compute.Network, string, error) {
+	network, err := f.computeNetworks.Find("network", networkID)
+	if err != nil {
+		return network, "", err
+	}
+
+	network.UsedIPs++
+	f.computeNetworks.Put(network.ID, network)
+
+	if requested != "" {
+		return network, requested, nil
+	}
+
+	return network, fakeAddress(network.AllocationPoolStart, network.UsedIPs-1), nil
+}
+
+func (f *fakeAPI) registerComputeKeyPairRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/key-pairs", func(r fakeRequest) (interface{}, error) {
+		return f.computeKeyPairs.List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/key-pairs", func(r fakeRequest) (interface{}, error) {
+		var body compute.KeyPairCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		fields := strings.Fields(body.PublicKey)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("invalid public key")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %s", err)
+		}
+
+		var fingerprint []string
+		for _, b := range md5.Sum(key) {
+			fingerprint = append(fingerprint, fmt.Sprintf("%02x", b))
+		}
+
+		keyPair := compute.KeyPair{
+			ID:          f.nextID(),
+			Name:        body.Name,
+			Fingerprint: strings.Join(fingerprint, ":"),
+		}
+
+		f.computeKeyPairs.Put(keyPair.ID, keyPair)
+		return keyPair, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/key-pairs/{}", func(r fakeRequest) (interface{}, error) {
+		if !f.computeKeyPairs.Delete(r.param(0)) {
+			return nil, fakeNotFound("key pair", r.param(0))
+		}
+
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) registerComputeCertificateRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/certificates", func(r fakeRequest) (interface{}, error) {
+		return f.computeCertificates.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/certificates/{}", func(r fakeRequest) (interface{}, error) {
+		return f.computeCertificates.Find("certificate", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/certificates", func(r fakeRequest) (interface{}, error) {
+		var body compute.CertificateCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		details, err := parseFakeCertificate(body.Certificate)
+		if err != nil {
+			return nil, err
+		}
+
+		certificate := compute.Certificate{
+			ID:       f.nextID(),
+			Name:     body.Name,
+			Location: location,
+			Type:     "custom",
+			Details:  details,
+		}
+
+		f.computeCertificates.Put(certificate.ID, certificate)
+		return certificate, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/certificates/{}", func(r fakeRequest) (interface{}, error) {
+		if !f.computeCertificates.Delete(r.param(0)) {
+			return nil, fakeNotFound("certificate", r.param(0))
+		}
+
+		return nil, nil
+	})
+}
+
+func parseFakeCertificate(encoded string) (compute.CertificateDetails, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return compute.CertificateDetails{}, fmt.Errorf("certificate must be base64 encoded: %s", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return compute.CertificateDetails{}, fmt.Errorf("certificate must be pem encoded")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return compute.CertificateDetails{}, fmt.Errorf("invalid certificate: %s", err)
+	}
+
+	attributes := func(name []string) string {
+		return strings.Join(name, ", ")
+	}
+
+	return compute.CertificateDetails{
+		Subject: map[string]string{
+			"CN": certificate.Subject.CommonName,
+			"OU": attributes(certificate.Subject.OrganizationalUnit),
+			"O":  attributes(certificate.Subject.Organization),
+			"L":  attributes(certificate.Subject.Locality),
+			"P":  attributes(certificate.Subject.Province),
+			"C":  attributes(certificate.Subject.Country),
+		},
+		Issuer: map[string]string{
+			"CN": certificate.Issuer.CommonName,
+			"OU": attributes(certificate.Issuer.OrganizationalUnit),
+			"O":  attributes(certificate.Issuer.Organization),
+			"L":  attributes(certificate.Issuer.Locality),
+			"P":  attributes(certificate.Issuer.Province),
+			"C":  attributes(certificate.Issuer.Country),
+		},
+		ValidFrom: common.Time(certificate.NotBefore),
+		ValidTo:   common.Time(certificate.NotAfter),
+		Serial:    certificate.SerialNumber.String(),
+	}, nil
+}
+
+func (f *fakeAPI) registerComputeElasticIPRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		return f.computeElasticIPs.List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		var body compute.ElasticIPCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		return f.createComputeElasticIP(location), nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/elastic-ips/{}", func(r fakeRequest) (interface{}, error) {
+		elasticIP, err := f.computeElasticIPs.Find("elastic ip", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if elasticIP.Attachment.ID != 0 {
+			return nil, fakeConflict("elastic ip %d is still attached to instance %d", elasticIP.ID, elasticIP.Attachment.ID)
+		}
+
+		f.computeElasticIPs.Delete(elasticIP.ID)
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) createComputeElasticIP(location common.Location) compute.ElasticIP {
+	product := f.productByType("compute-network-elastic-ip")
+
+	elasticIP := compute.ElasticIP{
+		ID:       f.nextID(),
+		Product:  compute.ElasticIPProduct{ID: product.ID, Name: product.Name, Type: product.Type.Key},
+		Location: location,
+		PublicIP: f.publicIP(),
+	}
+
+	f.computeElasticIPs.Put(elasticIP.ID, elasticIP)
+	return elasticIP
+}
+
+func (f *fakeAPI) registerComputeRouterRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/routers", func(r fakeRequest) (interface{}, error) {
+		return f.computeRouters.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/routers/{}", func(r fakeRequest) (interface{}, error) {
+		return f.computeRouters.Find("router", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/routers", func(r fakeRequest) (interface{}, error) {
+		var body compute.RouterCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		router := compute.Router{
+			ID:          f.nextID(),
+			Name:        body.Name,
+			Description: body.Description,
+			Location:    location,
+		}
+
+		f.setComputeRouterPublic(&router, body.Public)
+		f.computeRouters.Put(router.ID, router)
+		return router, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/routers/{}", func(r fakeRequest) (interface{}, error) {
+		router, err := f.computeRouters.Find("router", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		// public is omitted from the request if it is false, thus the presence of the fields has to be checked
+		var body struct {
+			Name        *string `json:"name"`
+			Description *string `json:"description"`
+			Public      *bool   `json:"public"`
+		}
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		if body.Name != nil {
+			router.Name = *body.Name
+		}
+
+		if body.Description != nil {
+			router.Description = *body.Description
+		}
+
+		if body.Public != nil {
+			f.setComputeRouterPublic(&router, *body.Public)
+		}
+
+		f.computeRouters.Put(router.ID, router)
+		return router, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/routers/{}", func(r fakeRequest) (interface{}, error) {
+		router, err := f.computeRouters.Find("router", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fakeChildren(f.computeRouterInterfaces, router.ID).List()) != 0 {
+			return nil, fakeConflict("router %d still has interfaces attached", router.ID)
+		}
+
+		f.computeRouters.Delete(router.ID)
+		delete(f.computeRoutes, router.ID)
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/routers/{}/interfaces", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeRouters.Find("router", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.computeRouterInterfaces, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/routers/{}/interfaces", func(r fakeRequest) (interface{}, error) {
+		router, err := f.computeRouters.Find("router", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.RouterInterfaceCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		network, err := f.computeNetworks.Find("network", body.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		if network.Location.ID != router.Location.ID {
+			return nil, fmt.Errorf("network %d is not in the location of router %d", network.ID, router.ID)
+		}
+
+		// router interfaces use the gateway of the network unless an address is requested explicitly
+		routerInterface := compute.RouterInterface{
+			ID:        f.nextID(),
+			PrivateIP: fakeDefault(body.PrivateIP, network.GatewayIP),
+			Network:   network,
+		}
+
+		fakeChildren(f.computeRouterInterfaces, router.ID).Put(routerInterface.ID, routerInterface)
+		return routerInterface, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/routers/{}/interfaces/{}", func(r fakeRequest) (interface{}, error) {
+		if !fakeChildren(f.computeRouterInterfaces, r.param(0)).Delete(r.param(1)) {
+			return nil, fakeNotFound("router interface", r.param(1))
+		}
+
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/routers/{}/routes", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeRouters.Find("router", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.computeRoutes, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/routers/{}/routes", func(r fakeRequest) (interface{}, error) {
+		router, err := f.computeRouters.Find("router", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.RouteCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		route := compute.Route{
+			ID:          f.nextID(),
+			Destination: body.Destination,
+			NextHop:     body.NextHop,
+		}
+
+		fakeChildren(f.computeRoutes, router.ID).Put(route.ID, route)
+		return route, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/routers/{}/routes/{}", func(r fakeRequest) (interface{}, error) {
+		if !fakeChildren(f.computeRoutes, r.param(0)).Delete(r.param(1)) {
+			return nil, fakeNotFound("route", r.param(1))
+		}
+
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) setComputeRouterPublic(router *compute.Router, public bool) {
+	router.Public = public
+	router.SourceNAT = public
+
+	if !public {
+		router.PublicIP = ""
+	} else if router.PublicIP == "" {
+		router.PublicIP = f.publicIP()
+	}
+}
+
+func (f *fakeAPI) registerComputeSecurityGroupRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/security-groups", func(r fakeRequest) (interface{}, error) {
+		return f.computeSecurityGroups.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/security-groups/{}", func(r fakeRequest) (interface{}, error) {
+		return f.computeSecurityGroups.Find("security group", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/security-groups", func(r fakeRequest) (interface{}, error) {
+		var body compute.SecurityGroupCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		securityGroup := compute.SecurityGroup{
+			ID:          f.nextID(),
+			Name:        body.Name,
+			Description: body.Description,
+			Location:    location,
+		}
+
+		f.computeSecurityGroups.Put(securityGroup.ID, securityGroup)
+		return securityGroup, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/security-groups/{}", func(r fakeRequest) (interface{}, error) {
+		securityGroup, err := f.computeSecurityGroups.Find("security group", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if securityGroup.Immutable {
+			return nil, fakeConflict("security group %d is immutable", securityGroup.ID)
+		}
+
+		var body compute.SecurityGroupUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		securityGroup.Name = body.Name
+		securityGroup.Description = body.Description
+
+		f.computeSecurityGroups.Put(securityGroup.ID, securityGroup)
+		return securityGroup, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/security-groups/{}", func(r fakeRequest) (interface{}, error) {
+		securityGroup, err := f.computeSecurityGroups.Find("security group", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if securityGroup.Default {
+			return nil, fakeConflict("the default security group can not be deleted")
+		}
+
+		for _, interfaces := range f.computeNetworkInterfaces {
+			for _, networkInterface := range interfaces.List() {
+				for _, attached := range networkInterface.SecurityGroups {
+					if attached.ID == securityGroup.ID {
+						return nil, fakeConflict("security group %d is still in use", securityGroup.ID)
+					}
+				}
+			}
+		}
+
+		f.computeSecurityGroups.Delete(securityGroup.ID)
+		delete(f.computeSecurityGroupRules, securityGroup.ID)
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/security-groups/{}/rules", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeSecurityGroups.Find("security group", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.computeSecurityGroupRules, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/security-groups/{}/rules", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeSecurityGroups.Find("security group", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		var body compute.SecurityGroupRuleOptions
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		rule, err := f.computeSecurityGroupRule(f.nextID(), body)
+		if err != nil {
+			return nil, err
+		}
+
+		fakeChildren(f.computeSecurityGroupRules, r.param(0)).Put(rule.ID, rule)
+		return rule, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/security-groups/{}/rules/{}", func(r fakeRequest) (interface{}, error) {
+		rules := fakeChildren(f.computeSecurityGroupRules, r.param(0))
+		if _, err := rules.Find("security group rule", r.param(1)); err != nil {
+			return nil, err
+		}
+
+		var body compute.SecurityGroupRuleOptions
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		rule, err := f.computeSecurityGroupRule(r.param(1), body)
+		if err != nil {
+			return nil, err
+		}
+
+		rules.Put(rule.ID, rule)
+		return rule, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/security-groups/{}/rules/{}", func(r fakeRequest) (interface{}, error) {
+		if !fakeChildren(f.computeSecurityGroupRules, r.param(0)).Delete(r.param(1)) {
+			return nil, fakeNotFound("security group rule", r.param(1))
+		}
+
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) computeSecurityGroupRule(id int, options compute.SecurityGroupRuleOptions) (compute.SecurityGroupRule, error) {
+	if options.Direction != compute.DirectionIngress && options.Direction != compute.DirectionEgress {
+		return compute.SecurityGroupRule{}, fmt.Errorf("invalid direction %q", options.Direction)
+	}
+
+	rule := compute.SecurityGroupRule{
+		ID:        id,
+		Direction: options.Direction,
+		Protocol:  options.Protocol,
+		FromPort:  options.FromPort,
+		ToPort:    options.ToPort,
+		ICMPType:  options.ICMPType,
+		ICMPCode:  options.ICMPCode,
+		IPRange:   options.IPRange,
+	}
+
+	if options.RemoteSecurityGroupID != 0 {
+		remote, err := f.computeSecurityGroups.Find("security group", options.RemoteSecurityGroupID)
+		if err != nil {
+			return rule, err
+		}
+
+		rule.RemoteSecurityGroup = remote
+	}
+
+	return rule, nil
+}
+
+func (f *fakeAPI) registerComputeServerRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/instances", func(r fakeRequest) (interface{}, error) {
+		var servers []compute.Server
+		for _, server := range f.computeServers.List() {
+			servers = append(servers, f.renderComputeServer(server))
+		}
+
+		return fakeList(servers), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/instances/{}", func(r fakeRequest) (interface{}, error) {
+		f.advance("server", r.param(0))
+
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		return f.renderComputeServer(server), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/instances", func(r fakeRequest) (interface{}, error) {
+		var body compute.ServerCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		image, err := f.image(body.ImageID)
+		if err != nil {
+			return nil, err
+		}
+
+		product, err := f.product(body.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		var keyPair compute.KeyPair
+		if body.KeyPairID != 0 {
+			keyPair, err = f.computeKeyPairs.Find("key pair", body.KeyPairID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		networkID := body.NetworkID
+		if networkID == 0 {
+			networkID = f.defaultComputeNetwork(location.ID)
+		}
+
+		if _, err := f.computeNetworks.Find("network", networkID); err != nil {
+			return nil, err
+		}
+
+		return f.order(body.Name, func() int {
+			server := compute.Server{
+				ID:       f.nextID(),
+				Name:     body.Name,
+				Status:   fakeServerStatus(compute.ServerStatusRunning),
+				Image:    image,
+				Product:  product,
+				Location: location,
+				KeyPair:  keyPair,
+			}
+
+			f.computeServers.Put(server.ID, server)
+
+			networkInterface, _ := f.createComputeNetworkInterface(server, networkID, body.PrivateIP)
+			if body.AttachExternalIP {
+				f.attachComputeElasticIP(server, networkInterface, f.createComputeElasticIP(location))
+			}
+
+			return server.ID
+		}), nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/instances/{}", func(r fakeRequest) (interface{}, error) {
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.ServerUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		server.Name = fakeDefault(body.Name, server.Name)
+
+		f.computeServers.Put(server.ID, server)
+		return f.renderComputeServer(server), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/instances/{}/action", func(r fakeRequest) (interface{}, error) {
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.ServerPerform
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		if f.pending("server", server.ID) {
+			return nil, fakeConflict("server %d is busy", server.ID)
+		}
+
+		required, transitional, final := 0, 0, 0
+		switch body.Action {
+		case "start":
+			required, transitional, final = compute.ServerStatusStopped, compute.ServerStatusStarting, compute.ServerStatusRunning
+		case "stop":
+			required, transitional, final = compute.ServerStatusRunning, compute.ServerStatusStopping, compute.ServerStatusStopped
+		case "reboot":
+			required, transitional, final = compute.ServerStatusRunning, compute.ServerStatusStarting, compute.ServerStatusRunning
+		default:
+			return nil, fmt.Errorf("unknown action %q", body.Action)
+		}
+
+		if server.Status.ID != required {
+			return nil, fakeConflict("action %s is not available for server %d while it is %s", body.Action, server.ID, server.Status.Key)
+		}
+
+		server.Status = fakeServerStatus(transitional)
+		f.computeServers.Put(server.ID, server)
+
+		f.schedule("server", server.ID, func() {
+			server, _ := f.computeServers.Get(server.ID)
+			server.Status = fakeServerStatus(final)
+			f.computeServers.Put(server.ID, server)
+		})
+
+		return f.renderComputeServer(server), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/instances/{}/upgrade", func(r fakeRequest) (interface{}, error) {
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.ServerUpgrade
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		product, err := f.product(body.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		if product.Type.Key != server.Product.Type.Key || product.ID == server.Product.ID || fakeProductShrinks(server.Product, product) {
+			return nil, fmt.Errorf("server %d can not be upgraded to product %d", server.ID, product.ID)
+		}
+
+		server.Status = fakeServerStatus(compute.ServerStatusUpgrading)
+		f.computeServers.Put(server.ID, server)
+
+		return f.order(server.Name, func() int {
+			server, _ := f.computeServers.Get(server.ID)
+			server.Product = product
+			server.Status = fakeServerStatus(compute.ServerStatusRunning)
+			f.computeServers.Put(server.ID, server)
+
+			return server.ID
+		}), nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/instances/{}", func(r fakeRequest) (interface{}, error) {
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		deleteElasticIP := r.URL.Query().Get("delete_elastic_ip") == "true"
+
+		// servers are deleted asynchronously and remain visible until they have been torn down
+		f.schedule("server", server.ID, func() {
+			for _, networkInterface := range fakeChildren(f.computeNetworkInterfaces, server.ID).List() {
+				f.detachComputeElasticIP(server.ID, networkInterface)
+
+				if deleteElasticIP && networkInterface.AttachedElasticIP.ID != 0 {
+					f.computeElasticIPs.Delete(networkInterface.AttachedElasticIP.ID)
+				}
+			}
+
+			for _, volume := range f.computeVolumes.List() {
+				if volume.AttachedTo.ID == server.ID {
+					volume.AttachedTo = compute.Server{}
+					volume.Status = fakeVolumeStatus(compute.VolumeStatusAvailable)
+					f.computeVolumes.Put(volume.ID, volume)
+				}
+			}
+
+			delete(f.computeNetworkInterfaces, server.ID)
+			f.computeServers.Delete(server.ID)
+		})
+
+		return nil, nil
+	})
+
+	f.registerComputeNetworkInterfaceRoutes()
+	f.registerComputeServerElasticIPRoutes()
+}
+
+// renderComputeServer fills in the network attachments of the server from its network interfaces.
+func (f *fakeAPI) renderComputeServer(server compute.Server) compute.Server {
+	server.Networks = nil
+
+	for _, networkInterface := range fakeChildren(f.computeNetworkInterfaces, server.ID).List() {
+		attached := compute.AttachedNetworkInterface{
+			ID:        networkInterface.ID,
+			PrivateIP: networkInterface.PrivateIP,
+			PublicIP:  networkInterface.AttachedElasticIP.PublicIP,
+		}
+
+		found := false
+		for i := range server.Networks {
+			if server.Networks[i].ID == networkInterface.Network.ID {
+				server.Networks[i].Interfaces = append(server.Networks[i].Interfaces, attached)
+				found = true
+			}
+		}
+
+		if !found {
+			network, _ := f.computeNetworks.Get(networkInterface.Network.ID)
+			server.Networks = append(server.Networks, compute.ServerNetworkAttachment{
+				Network:    network,
+				Interfaces: []compute.AttachedNetworkInterface{attached},
+			})
+		}
+	}
+
+	return server
+}
+
+func (f *fakeAPI) defaultComputeNetwork(locationID int) int {
+	for _, network := range f.computeNetworks.List() {
+		if network.Location.ID == locationID {
+			return network.ID
+		}
+	}
+
+	return 0
+}
+
+func (f *fakeAPI) defaultComputeSecurityGroup(locationID int) []compute.SecurityGroup {
+	for _, securityGroup := range f.computeSecurityGroups.List() {
+		if securityGroup.Default && securityGroup.Location.ID == locationID {
+			return []compute.SecurityGroup{securityGroup}
+		}
+	}
+
+	return []compute.SecurityGroup{}
+}
+
+func (f *fakeAPI) createComputeNetworkInterface(server compute.Server, networkID int, privateIP string) (compute.NetworkInterface, error) {
+	network, address, err := f.allocateComputeIP(networkID, privateIP)
+	if err != nil {
+		return compute.NetworkInterface{}, err
+	}
+
+	id := f.nextID()
+	networkInterface := compute.NetworkInterface{
+		ID:             id,
+		PrivateIP:      address,
+		MacAddress:     fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", (id>>16)&0xff, (id>>8)&0xff, id&0xff),
+		Network:        network,
+		SecurityGroups: f.defaultComputeSecurityGroup(server.Location.ID),
+		Security:       true,
+	}
+
+	fakeChildren(f.computeNetworkInterfaces, server.ID).Put(networkInterface.ID, networkInterface)
+	return networkInterface, nil
+}
+
+func (f *fakeAPI) attachComputeElasticIP(server compute.Server, networkInterface compute.NetworkInterface, elasticIP compute.ElasticIP) compute.ElasticIP {
+	elasticIP.PrivateIP = networkInterface.PrivateIP
+	elasticIP.Attachment = compute.ElasticIPAttachment{ID: server.ID, Name: server.Name, Type: "instance"}
+	f.computeElasticIPs.Put(elasticIP.ID, elasticIP)
+
+	networkInterface.AttachedElasticIP = elasticIP
+	fakeChildren(f.computeNetworkInterfaces, server.ID).Put(networkInterface.ID, networkInterface)
+
+	return elasticIP
+}
+
+func (f *fakeAPI) detachComputeElasticIP(serverID int, networkInterface compute.NetworkInterface) {
+	if networkInterface.AttachedElasticIP.ID == 0 {
+		return
+	}
+
+	elasticIP, ok := f.computeElasticIPs.Get(networkInterface.AttachedElasticIP.ID)
+	if ok {
+		elasticIP.PrivateIP = ""
+		elasticIP.Attachment = compute.ElasticIPAttachment{}
+		f.computeElasticIPs.Put(elasticIP.ID, elasticIP)
+	}
+
+	networkInterface.AttachedElasticIP = compute.ElasticIP{}
+	fakeChildren(f.computeNetworkInterfaces, serverID).Put(networkInterface.ID, networkInterface)
+}
+
+func (f *fakeAPI) registerComputeNetworkInterfaceRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/instances/{}/network-interfaces", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeServers.Find("server", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.computeNetworkInterfaces, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/instances/{}/network-interfaces", func(r fakeRequest) (interface{}, error) {
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.NetworkInterfaceCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		return f.createComputeNetworkInterface(server, body.NetworkID, body.PrivateIP)
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/instances/{}/network-interfaces/{}/security", func(r fakeRequest) (interface{}, error) {
+		interfaces := fakeChildren(f.computeNetworkInterfaces, r.param(0))
+
+		networkInterface, err := interfaces.Find("network interface", r.param(1))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.NetworkInterfaceSecurityUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		networkInterface.Security = body.Security
+		if !body.Security {
+			networkInterface.SecurityGroups = []compute.SecurityGroup{}
+		}
+
+		interfaces.Put(networkInterface.ID, networkInterface)
+		return networkInterface, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/instances/{}/network-interfaces/{}/security-groups", func(r fakeRequest) (interface{}, error) {
+		interfaces := fakeChildren(f.computeNetworkInterfaces, r.param(0))
+
+		networkInterface, err := interfaces.Find("network interface", r.param(1))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.NetworkInterfaceSecurityGroupUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		if !networkInterface.Security {
+			return nil, fakeConflict("security is disabled on network interface %d", networkInterface.ID)
+		}
+
+		securityGroups := []compute.SecurityGroup{}
+		for _, id := range body.SecurityGroupIDs {
+			securityGroup, err := f.computeSecurityGroups.Find("security group", id)
+			if err != nil {
+				return nil, err
+			}
+
+			securityGroups = append(securityGroups, securityGroup)
+		}
+
+		networkInterface.SecurityGroups = securityGroups
+
+		interfaces.Put(networkInterface.ID, networkInterface)
+		return networkInterface, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/instances/{}/network-interfaces/{}", func(r fakeRequest) (interface{}, error) {
+		interfaces := fakeChildren(f.computeNetworkInterfaces, r.param(0))
+
+		networkInterface, err := interfaces.Find("network interface", r.param(1))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(interfaces.List()) == 1 {
+			return nil, fakeConflict("the last network interface of a server can not be removed")
+		}
+
+		f.detachComputeElasticIP(r.param(0), networkInterface)
+		interfaces.Delete(networkInterface.ID)
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) registerComputeServerElasticIPRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/instances/{}/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeServers.Find("server", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		var elasticIPs []compute.ElasticIP
+		for _, elasticIP := range f.computeElasticIPs.List() {
+			if elasticIP.Attachment.ID == r.param(0) {
+				elasticIPs = append(elasticIPs, elasticIP)
+			}
+		}
+
+		return fakeList(elasticIPs), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/instances/{}/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		server, err := f.computeServers.Find("server", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.ElasticIPAttach
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		elasticIP, err := f.computeElasticIPs.Find("elastic ip", body.ElasticIPID)
+		if err != nil {
+			return nil, err
+		}
+
+		networkInterface, err := fakeChildren(f.computeNetworkInterfaces, server.ID).Find("network interface", body.NetworkInterfaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if elasticIP.Attachment.ID != 0 {
+			return nil, fakeConflict("elastic ip %d is already attached", elasticIP.ID)
+		}
+
+		if networkInterface.AttachedElasticIP.ID != 0 {
+			return nil, fakeConflict("network interface %d already has an elastic ip attached", networkInterface.ID)
+		}
+
+		return f.attachComputeElasticIP(server, networkInterface, elasticIP), nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/instances/{}/elastic-ips/{}", func(r fakeRequest) (interface{}, error) {
+		for _, networkInterface := range fakeChildren(f.computeNetworkInterfaces, r.param(0)).List() {
+			if networkInterface.AttachedElasticIP.ID == r.param(1) {
+				f.detachComputeElasticIP(r.param(0), networkInterface)
+				return nil, nil
+			}
+		}
+
+		return nil, fakeNotFound("elastic ip", r.param(1))
+	})
+}
+
+func (f *fakeAPI) registerComputeVolumeRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/volumes", func(r fakeRequest) (interface{}, error) {
+		return f.computeVolumes.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/volumes/{}", func(r fakeRequest) (interface{}, error) {
+		f.advance("volume", r.param(0))
+		return f.computeVolumes.Find("volume", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/volumes", func(r fakeRequest) (interface{}, error) {
+		var body compute.VolumeCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if body.SnapshotID != 0 {
+			snapshot, err := f.computeSnapshots.Find("snapshot", body.SnapshotID)
+			if err != nil {
+				return nil, err
+			}
+
+			if body.Size < snapshot.Size {
+				return nil, fmt.Errorf("volume must be at least as large as snapshot %d", snapshot.ID)
+			}
+		}
+
+		var server compute.Server
+		if body.InstanceID != 0 {
+			server, err = f.computeServers.Find("server", body.InstanceID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		volume := compute.Volume{
+			ID:           f.nextID(),
+			Product:      f.productByType("compute-storage-volume"),
+			Location:     location,
+			Status:       fakeVolumeStatus(compute.VolumeStatusWorking),
+			Name:         body.Name,
+			Size:         body.Size,
+			SerialNumber: fmt.Sprintf("fake-%d", f.lastID),
+			CreatedAt:    common.Time(time.Now().Truncate(time.Second)),
+		}
+
+		f.computeVolumes.Put(volume.ID, volume)
+
+		f.schedule("volume", volume.ID, func() {
+			volume, _ := f.computeVolumes.Get(volume.ID)
+			volume.Status = fakeVolumeStatus(compute.VolumeStatusAvailable)
+
+			if server.ID != 0 {
+				volume.AttachedTo = compute.Server{ID: server.ID, Name: server.Name}
+				volume.Status = fakeVolumeStatus(compute.VolumeStatusInUse)
+			}
+
+			f.computeVolumes.Put(volume.ID, volume)
+		})
+
+		return volume, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/volumes/{}", func(r fakeRequest) (interface{}, error) {
+		volume, err := f.computeVolumes.Find("volume", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.VolumeUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		volume.Name = fakeDefault(body.Name, volume.Name)
+
+		f.computeVolumes.Put(volume.ID, volume)
+		return volume, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/volumes/{}", func(r fakeRequest) (interface{}, error) {
+		volume, err := f.computeVolumes.Find("volume", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if volume.AttachedTo.ID != 0 {
+			return nil, fakeConflict("volume %d is still attached to instance %d", volume.ID, volume.AttachedTo.ID)
+		}
+
+		f.computeVolumes.Delete(volume.ID)
+		return nil, nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/volumes/{}/instances", func(r fakeRequest) (interface{}, error) {
+		volume, err := f.computeVolumes.Find("volume", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.VolumeAttach
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		server, err := f.computeServers.Find("server", body.InstanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if volume.AttachedTo.ID != 0 {
+			return nil, fakeConflict("volume %d is already attached to instance %d", volume.ID, volume.AttachedTo.ID)
+		}
+
+		volume.AttachedTo = compute.Server{ID: server.ID, Name: server.Name}
+		volume.Status = fakeVolumeStatus(compute.VolumeStatusInUse)
+
+		f.computeVolumes.Put(volume.ID, volume)
+		return volume, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/volumes/{}/instances/{}", func(r fakeRequest) (interface{}, error) {
+		volume, err := f.computeVolumes.Find("volume", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if volume.AttachedTo.ID != r.param(1) {
+			return nil, fakeNotFound("volume attachment", r.param(1))
+		}
+
+		volume.AttachedTo = compute.Server{}
+		volume.Status = fakeVolumeStatus(compute.VolumeStatusAvailable)
+
+		f.computeVolumes.Put(volume.ID, volume)
+		return nil, nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/volumes/{}/upgrade", func(r fakeRequest) (interface{}, error) {
+		volume, err := f.computeVolumes.Find("volume", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.VolumeExpand
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		if body.Size <= volume.Size {
+			return nil, fmt.Errorf("volumes can only be expanded")
+		}
+
+		status := volume.Status
+		volume.Size = body.Size
+		volume.Status = fakeVolumeStatus(compute.VolumeStatusWorking)
+		f.computeVolumes.Put(volume.ID, volume)
+
+		f.schedule("volume", volume.ID, func() {
+			volume, _ := f.computeVolumes.Get(volume.ID)
+			volume.Status = status
+			f.computeVolumes.Put(volume.ID, volume)
+		})
+
+		return volume, nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/volumes/{}/revert", func(r fakeRequest) (interface{}, error) {
+		volume, err := f.computeVolumes.Find("volume", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.VolumeRevert
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		snapshot, err := f.computeSnapshots.Find("snapshot", body.SnapshotID)
+		if err != nil {
+			return nil, err
+		}
+
+		if snapshot.Volume.ID != volume.ID {
+			return nil, fmt.Errorf("snapshot %d does not belong to volume %d", snapshot.ID, volume.ID)
+		}
+
+		return volume, nil
+	})
+}
+
+func (f *fakeAPI) registerComputeSnapshotRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/snapshots", func(r fakeRequest) (interface{}, error) {
+		return f.computeSnapshots.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/snapshots/{}", func(r fakeRequest) (interface{}, error) {
+		f.advance("snapshot", r.param(0))
+		return f.computeSnapshots.Find("snapshot", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/snapshots", func(r fakeRequest) (interface{}, error) {
+		var body compute.SnapshotCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		volume, err := f.computeVolumes.Find("volume", body.VolumeID)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot := compute.Snapshot{
+			ID:        f.nextID(),
+			Name:      body.Name,
+			Size:      volume.Size,
+			Status:    fakeSnapshotStatus(compute.SnapshotStatusCreating),
+			Volume:    volume,
+			Product:   f.productByType("compute-storage-snapshot"),
+			CreatedAt: common.Time(time.Now().Truncate(time.Second)),
+		}
+
+		f.computeSnapshots.Put(snapshot.ID, snapshot)
+
+		volume.Snapshots++
+		f.computeVolumes.Put(volume.ID, volume)
+
+		f.schedule("snapshot", snapshot.ID, func() {
+			snapshot, _ := f.computeSnapshots.Get(snapshot.ID)
+			snapshot.Status = fakeSnapshotStatus(compute.SnapshotStatusAvailable)
+			f.computeSnapshots.Put(snapshot.ID, snapshot)
+		})
+
+		return snapshot, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/snapshots/{}", func(r fakeRequest) (interface{}, error) {
+		snapshot, err := f.computeSnapshots.Find("snapshot", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.SnapshotUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		snapshot.Name = fakeDefault(body.Name, snapshot.Name)
+
+		f.computeSnapshots.Put(snapshot.ID, snapshot)
+		return snapshot, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/snapshots/{}", func(r fakeRequest) (interface{}, error) {
+		snapshot, err := f.computeSnapshots.Find("snapshot", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if volume, ok := f.computeVolumes.Get(snapshot.Volume.ID); ok {
+			volume.Snapshots--
+			f.computeVolumes.Put(volume.ID, volume)
+		}
+
+		f.computeSnapshots.Delete(snapshot.ID)
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) registerComputeLoadBalancerRoutes() {
+	f.handle(http.MethodGet, "/v4/compute/load-balancers", func(r fakeRequest) (interface{}, error) {
+		return f.computeLoadBalancers.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/load-balancers/{}", func(r fakeRequest) (interface{}, error) {
+		f.advance("load balancer", r.param(0))
+		return f.computeLoadBalancers.Find("load balancer", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/load-balancers", func(r fakeRequest) (interface{}, error) {
+		var body compute.LoadBalancerCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.computeNetworks.Find("network", body.NetworkID); err != nil {
+			return nil, err
+		}
+
+		return f.order(body.Name, func() int {
+			network, address, _ := f.allocateComputeIP(body.NetworkID, body.PrivateIP)
+
+			attached := compute.AttachedLoadBalancerInterface{ID: f.nextID(), PrivateIP: address}
+			if body.AttachExternalIP {
+				attached.PublicIP = f.publicIP()
+			}
+
+			loadBalancer := compute.LoadBalancer{
+				ID:       f.nextID(),
+				Name:     body.Name,
+				Location: location,
+				Product:  f.productByType("compute-network-load-balancer"),
+				Status:   fakeLoadBalancerStatus(compute.LoadBalancerStatusActive),
+				Networks: []compute.LoadBalancerNetworkAttachment{
+					{Network: network, Interfaces: []compute.AttachedLoadBalancerInterface{attached}},
+				},
+			}
+
+			f.computeLoadBalancers.Put(loadBalancer.ID, loadBalancer)
+			f.changeComputeLoadBalancer(loadBalancer.ID)
+
+			return loadBalancer.ID
+		}), nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/load-balancers/{}", func(r fakeRequest) (interface{}, error) {
+		loadBalancer, err := f.computeLoadBalancers.Find("load balancer", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.LoadBalancerUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		loadBalancer.Name = fakeDefault(body.Name, loadBalancer.Name)
+
+		f.computeLoadBalancers.Put(loadBalancer.ID, loadBalancer)
+		return loadBalancer, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/load-balancers/{}", func(r fakeRequest) (interface{}, error) {
+		if !f.computeLoadBalancers.Delete(r.param(0)) {
+			return nil, fakeNotFound("load balancer", r.param(0))
+		}
+
+		delete(f.computeLoadBalancerPools, r.param(0))
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/load-balancers/{}/balancing-pools", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.computeLoadBalancers.Find("load balancer", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.computeLoadBalancerPools, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/load-balancers/{}/balancing-pools/{}", func(r fakeRequest) (interface{}, error) {
+		return fakeChildren(f.computeLoadBalancerPools, r.param(0)).Find("load balancer pool", r.param(1))
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/load-balancers/{}/balancing-pools", func(r fakeRequest) (interface{}, error) {
+		loadBalancer, err := f.mutableComputeLoadBalancer(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.LoadBalancerPoolCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		pool := compute.LoadBalancerPool{
+			ID:            f.nextID(),
+			Name:          fmt.Sprintf("pool-%d", f.lastID),
+			Status:        fakeLoadBalancerStatus(compute.LoadBalancerStatusActive),
+			EntryPort:     body.EntryPort,
+			StickySession: body.StickySession,
+		}
+
+		if pool.EntryProtocol, err = f.loadBalancerProtocol(body.EntryProtocolID); err != nil {
+			return nil, err
+		}
+
+		if pool.TargetProtocol, err = f.loadBalancerProtocol(body.TargetProtocolID); err != nil {
+			return nil, err
+		}
+
+		if err = f.updateComputeLoadBalancerPool(&pool, body.CertificateID, body.BalancingAlgorithmID, body.HealthCheck); err != nil {
+			return nil, err
+		}
+
+		fakeChildren(f.computeLoadBalancerPools, loadBalancer.ID).Put(pool.ID, pool)
+
+		for _, create := range body.Members {
+			member := f.computeLoadBalancerMember(create)
+			fakeChildren(f.computeLoadBalancerMembers, pool.ID).Put(member.ID, member)
+		}
+
+		f.changeComputeLoadBalancer(loadBalancer.ID)
+		return pool, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/compute/load-balancers/{}/balancing-pools/{}", func(r fakeRequest) (interface{}, error) {
+		loadBalancer, err := f.mutableComputeLoadBalancer(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		pools := fakeChildren(f.computeLoadBalancerPools, loadBalancer.ID)
+
+		pool, err := pools.Find("load balancer pool", r.param(1))
+		if err != nil {
+			return nil, err
+		}
+
+		var body compute.LoadBalancerPoolUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		pool.StickySession = body.StickySession
+		if err = f.updateComputeLoadBalancerPool(&pool, body.CertificateID, body.BalancingAlgorithmID, body.HealthCheck); err != nil {
+			return nil, err
+		}
+
+		pools.Put(pool.ID, pool)
+
+		f.changeComputeLoadBalancer(loadBalancer.ID)
+		return pool, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/load-balancers/{}/balancing-pools/{}", func(r fakeRequest) (interface{}, error) {
+		loadBalancer, err := f.mutableComputeLoadBalancer(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if !fakeChildren(f.computeLoadBalancerPools, loadBalancer.ID).Delete(r.param(1)) {
+			return nil, fakeNotFound("load balancer pool", r.param(1))
+		}
+
+		delete(f.computeLoadBalancerMembers, r.param(1))
+
+		f.changeComputeLoadBalancer(loadBalancer.ID)
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/compute/load-balancers/{}/balancing-pools/{}/members", func(r fakeRequest) (interface{}, error) {
+		if _, err := fakeChildren(f.computeLoadBalancerPools, r.param(0)).Find("load balancer pool", r.param(1)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.computeLoadBalancerMembers, r.param(1)).List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/compute/load-balancers/{}/balancing-pools/{}/members", func(r fakeRequest) (interface{}, error) {
+		loadBalancer, err := f.mutableComputeLoadBalancer(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := fakeChildren(f.computeLoadBalancerPools, loadBalancer.ID).Find("load balancer pool", r.param(1)); err != nil {
+			return nil, err
+		}
+
+		var body compute.LoadBalancerMemberCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		member := f.computeLoadBalancerMember(body)
+		fakeChildren(f.computeLoadBalancerMembers, r.param(1)).Put(member.ID, member)
+
+		f.changeComputeLoadBalancer(loadBalancer.ID)
+		return member, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/compute/load-balancers/{}/balancing-pools/{}/members/{}", func(r fakeRequest) (interface{}, error) {
+		loadBalancer, err := f.mutableComputeLoadBalancer(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if !fakeChildren(f.computeLoadBalancerMembers, r.param(1)).Delete(r.param(2)) {
+			return nil, fakeNotFound("load balancer member", r.param(2))
+		}
+
+		f.changeComputeLoadBalancer(loadBalancer.ID)
+		return nil, nil
+	})
+}
+
+// mutableComputeLoadBalancer returns the load balancer if it currently accepts changes to its pools and members.
+func (f *fakeAPI) mutableComputeLoadBalancer(id int) (compute.LoadBalancer, error) {
+	loadBalancer, err := f.computeLoadBalancers.Find("load balancer", id)
+	if err != nil {
+		return loadBalancer, err
+	}
+
+	if loadBalancer.Status.ID == compute.LoadBalancerStatusWorking {
+		return loadBalancer, fakeConflict("load balancer %d is currently working", loadBalancer.ID)
+	}
+
+	return loadBalancer, nil
+}
+
+// changeComputeLoadBalancer marks the load balancer as working until the pending change has been applied.
+func (f *fakeAPI) changeComputeLoadBalancer(id int) {
+	loadBalancer, _ := f.computeLoadBalancers.Get(id)
+	loadBalancer.Status = fakeLoadBalancerStatus(compute.LoadBalancerStatusWorking)
+	f.computeLoadBalancers.Put(id, loadBalancer)
+
+	f.schedule("load balancer", id, func() {
+		loadBalancer, _ := f.computeLoadBalancers.Get(id)
+		loadBalancer.Status = fakeLoadBalancerStatus(compute.LoadBalancerStatusActive)
+		f.computeLoadBalancers.Put(id, loadBalancer)
+	})
+}
+
+func (f *fakeAPI) updateComputeLoadBalancerPool(pool *compute.LoadBalancerPool, certificateID int, algorithmID int, healthCheck compute.LoadBalancerHealthCheckOptions) (err error) {
+	if certificateID != 0 {
+		if pool.Certificate, err = f.computeCertificates.Find("certificate", certificateID); err != nil {
+			return err
+		}
+	}
+
+	if algorithmID != 0 {
+		if pool.Algorithm, err = f.loadBalancerAlgorithm(algorithmID); err != nil {
+			return err
+		}
+	}
+
+	if healthCheck.TypeID != 0 {
+		if pool.HealthCheck.Type, err = f.loadBalancerHealthCheckType(healthCheck.TypeID); err != nil {
+			return err
+		}
+
+		pool.HealthCheck.HTTPMethod = healthCheck.HTTPMethod
+		pool.HealthCheck.HTTPPath = healthCheck.HTTPPath
+		pool.HealthCheck.Interval = fakeDefaultInt(healthCheck.Interval, 5)
+		pool.HealthCheck.Timeout = fakeDefaultInt(healthCheck.Timeout, 5)
+		pool.HealthCheck.HealthyThreshold = fakeDefaultInt(healthCheck.HealthyThreshold, 3)
+		pool.HealthCheck.UnhealthyThreshold = fakeDefaultInt(healthCheck.UnhealthyThreshold, 3)
+	}
+
+	return nil
+}
+
+func (f *fakeAPI) computeLoadBalancerMember(create compute.LoadBalancerMemberCreate) compute.LoadBalancerMember {
+	return compute.LoadBalancerMember{
+		ID:      f.nextID(),
+		Name:    create.Name,
+		Address: create.Address,
+		Port:    create.Port,
+		Status:  fakeLoadBalancerStatus(compute.LoadBalancerStatusActive),
+	}
+}
+
+func (f *fakeAPI) loadBalancerProtocol(id int) (compute.LoadBalancerProtocol, error) {
+	for _, protocol := range f.loadBalancerProtocols {
+		if protocol.ID == id {
+			return protocol, nil
+		}
+	}
+
+	return compute.LoadBalancerProtocol{}, fakeNotFound("load balancer protocol", id)
+}
+
+func (f *fakeAPI) loadBalancerAlgorithm(id int) (compute.LoadBalancerAlgorithm, error) {
+	for _, algorithm := range f.loadBalancerAlgorithms {
+		if algorithm.ID == id {
+			return algorithm, nil
+		}
+	}
+
+	return compute.LoadBalancerAlgorithm{}, fakeNotFound("load balancer algorithm", id)
+}
+
+func (f *fakeAPI) loadBalancerHealthCheckType(id int) (compute.LoadBalancerHealthCheckType, error) {
+	for _, healthCheck := range f.loadBalancerHealthChecks {
+		if healthCheck.ID == id {
+			return healthCheck, nil
+		}
+	}
+
+	return compute.LoadBalancerHealthCheckType{}, fakeNotFound("load balancer health check type", id)
+}
@@ -0,0 +1,393 @@
+package flow
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/flowswiss/goclient/kubernetes"
+)
+
+const fakeKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://%[2]s:6443
+    certificate-authority-data: %[3]s
+contexts:
+- name: %[1]s-admin@%[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s-admin
+current-context: %[1]s-admin@%[1]s
+users:
+- name: %[1]s-admin
+  user:
+    client-certificate-data: %[4]s
+    client-key-data: %[5]s
+`
+
+func fakeClusterStatus(id int) kubernetes.ClusterStatus {
+	statuses := map[int]kubernetes.ClusterStatus{
+		compute.ClusterStatusHealthy:   {ID: compute.ClusterStatusHealthy, Key: "healthy", Name: "Healthy"},
+		compute.ClusterStatusWorking:   {ID: compute.ClusterStatusWorking, Key: "working", Name: "Working"},
+		compute.ClusterStatusUnhealthy: {ID: compute.ClusterStatusUnhealthy, Key: "unhealthy", Name: "Unhealthy"},
+	}
+
+	return statuses[id]
+}
+
+func (f *fakeAPI) seedKubernetes() {
+	versions := []kubernetes.ClusterVersion{
+		{ID: 1, Name: "1.23.10", Major: 1, Minor: 23},
+		{ID: 2, Name: "1.24.4", Major: 1, Minor: 24},
+		{ID: 3, Name: "1.25.0", Major: 1, Minor: 25},
+	}
+
+	image, _ := f.image(1)
+
+	// every version can only be upgraded to the next minor version, whose upgrade paths are included as well
+	for i := len(versions) - 1; i >= 0; i-- {
+		versions[i].Schema = json.RawMessage(`{}`)
+		versions[i].HostImage = image
+		versions[i].UpgradePaths = []kubernetes.ClusterVersion{}
+
+		if i+1 < len(versions) {
+			versions[i].UpgradePaths = append(versions[i].UpgradePaths, versions[i+1])
+		}
+	}
+
+	f.kubernetesVersions = versions
+}
+
+func (f *fakeAPI) registerKubernetesRoutes() {
+	f.handle(http.MethodGet, "/v4/entities/kubernetes/versions", func(r fakeRequest) (interface{}, error) {
+		return f.kubernetesVersions, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/kubernetes/clusters", func(r fakeRequest) (interface{}, error) {
+		return f.kubernetesClusters.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/kubernetes/clusters/{}", func(r fakeRequest) (interface{}, error) {
+		f.advance("cluster", r.param(0))
+		return f.kubernetesClusters.Find("cluster", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/kubernetes/clusters", func(r fakeRequest) (interface{}, error) {
+		var body kubernetes.ClusterCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		network, err := f.computeNetworks.Find("network", body.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		worker, err := f.product(body.Worker.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		if body.Worker.Count < 1 {
+			return nil, fmt.Errorf("a cluster requires at least one worker")
+		}
+
+		return f.order(body.Name, func() int {
+			securityGroup := compute.SecurityGroup{
+				ID:          f.nextID(),
+				Name:        fmt.Sprintf("kubernetes-%s", body.Name),
+				Description: fmt.Sprintf("security group of the kubernetes cluster %s", body.Name),
+				Location:    location,
+				Immutable:   true,
+			}
+			f.computeSecurityGroups.Put(securityGroup.ID, securityGroup)
+
+			cluster := kubernetes.Cluster{
+				ID:            f.nextID(),
+				Name:          body.Name,
+				Location:      location,
+				Product:       f.productByType("kubernetes-cluster"),
+				Network:       network,
+				SecurityGroup: securityGroup,
+				Version:       f.kubernetesVersions[0],
+				Status:        fakeClusterStatus(compute.ClusterStatusHealthy),
+			}
+
+			cluster.DNSName = fmt.Sprintf("cluster-%d.kubernetes.example.com", cluster.ID)
+			if body.AttachExternalIP {
+				cluster.PublicAddress = f.publicIP()
+			}
+
+			cluster.NodeCount.Expected.ControlPlane = 3
+			cluster.NodeCount.Expected.Worker = body.Worker.Count
+			cluster.NodeCount.Current = cluster.NodeCount.Expected
+			cluster.ExpectedPreset.ControlPlane, _ = f.product(43)
+			cluster.ExpectedPreset.Worker = worker
+
+			now := time.Now().Truncate(time.Second)
+			cluster.KubeConfig.UpdatedAt = common.Time(now)
+			cluster.KubeConfig.ExpiresAt = common.Time(now.AddDate(1, 0, 0))
+
+			f.kubernetesClusters.Put(cluster.ID, cluster)
+			// the api defaults variables which are not configured
+			f.kubernetesConfigurations[cluster.ID] = kubernetes.ClusterConfiguration{
+				VersionID: cluster.Version.ID,
+				Variables: json.RawMessage(`{"load_balancer":{"proxy_protocol":false}}`),
+			}
+
+			return cluster.ID
+		}), nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/kubernetes/clusters/{}", func(r fakeRequest) (interface{}, error) {
+		cluster, err := f.kubernetesClusters.Find("cluster", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body kubernetes.ClusterUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		cluster.Name = fakeDefault(body.Name, cluster.Name)
+
+		f.kubernetesClusters.Put(cluster.ID, cluster)
+		return cluster, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/kubernetes/clusters/{}", func(r fakeRequest) (interface{}, error) {
+		cluster, err := f.kubernetesClusters.Find("cluster", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		// clusters are deleted asynchronously and remain visible until they have been torn down
+		f.schedule("cluster", cluster.ID, func() {
+			f.kubernetesClusters.Delete(cluster.ID)
+			f.computeSecurityGroups.Delete(cluster.SecurityGroup.ID)
+			delete(f.kubernetesConfigurations, cluster.ID)
+		})
+
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/kubernetes/clusters/{}/kube-config", func(r fakeRequest) (interface{}, error) {
+		cluster, err := f.kubernetesClusters.Find("cluster", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		encode := func(value string) string {
+			return base64.StdEncoding.EncodeToString([]byte(value))
+		}
+
+		kubeConfig := fmt.Sprintf(fakeKubeConfig,
+			cluster.Name,
+			cluster.DNSName,
+			encode(fmt.Sprintf("fake certificate authority of cluster %d", cluster.ID)),
+			encode(fmt.Sprintf("fake client certificate of cluster %d", cluster.ID)),
+			encode(fmt.Sprintf("fake client key of cluster %d", cluster.ID)),
+		)
+
+		return kubernetes.ClusterKubeConfig{KubeConfig: kubeConfig}, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/kubernetes/clusters/{}/configuration", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.kubernetesClusters.Find("cluster", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return f.kubernetesConfigurations[r.param(0)], nil
+	})
+
+	f.handle(http.MethodPut, "/v4/kubernetes/clusters/{}/configuration", func(r fakeRequest) (interface{}, error) {
+		cluster, err := f.workingKubernetesCluster(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body kubernetes.ClusterConfiguration
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		if body.VersionID != cluster.Version.ID {
+			version, ok := fakeUpgradePath(cluster.Version, body.VersionID)
+			if !ok {
+				return nil, fmt.Errorf("cluster %d can not be upgraded from version %s to version %d", cluster.ID, cluster.Version.Name, body.VersionID)
+			}
+
+			cluster.Version = f.kubernetesVersion(version.ID)
+		}
+
+		if len(body.Variables) == 0 {
+			body.Variables = json.RawMessage(`{}`)
+		}
+
+		f.kubernetesConfigurations[cluster.ID] = body
+		f.changeKubernetesCluster(cluster)
+
+		return body, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/kubernetes/clusters/{}/flavor", func(r fakeRequest) (interface{}, error) {
+		cluster, err := f.workingKubernetesCluster(r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body kubernetes.ClusterUpdateFlavor
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		worker, err := f.product(body.Worker.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		if body.Worker.Count < 1 {
+			return nil, fmt.Errorf("a cluster requires at least one worker")
+		}
+
+		cluster.NodeCount.Expected.Worker = body.Worker.Count
+		cluster.ExpectedPreset.Worker = worker
+
+		return f.changeKubernetesCluster(cluster), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/kubernetes/clusters/{}/nodes", func(r fakeRequest) (interface{}, error) {
+		cluster, err := f.kubernetesClusters.Find("cluster", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		return f.kubernetesNodes(cluster), nil
+	})
+}
+
+// workingKubernetesCluster returns the cluster if no other change is currently being applied to it.
+func (f *fakeAPI) workingKubernetesCluster(id int) (kubernetes.Cluster, error) {
+	cluster, err := f.kubernetesClusters.Find("cluster", id)
+	if err != nil {
+		return cluster, err
+	}
+
+	if cluster.Status.ID == compute.ClusterStatusWorking || f.pending("cluster", cluster.ID) {
+		return cluster, fakeConflict("cluster %d is currently working", cluster.ID)
+	}
+
+	return cluster, nil
+}
+
+// changeKubernetesCluster applies the change to the cluster once the platform has picked it up, which is not before
+// the cluster is polled. The cluster is then marked as working until the expected nodes have been provisioned.
+func (f *fakeAPI) changeKubernetesCluster(cluster kubernetes.Cluster) kubernetes.Cluster {
+	f.schedule("cluster", cluster.ID, func() {
+		cluster.Status = fakeClusterStatus(compute.ClusterStatusWorking)
+		f.kubernetesClusters.Put(cluster.ID, cluster)
+
+		f.schedule("cluster", cluster.ID, func() {
+			cluster, _ := f.kubernetesClusters.Get(cluster.ID)
+			cluster.NodeCount.Current = cluster.NodeCount.Expected
+			cluster.Status = fakeClusterStatus(compute.ClusterStatusHealthy)
+			f.kubernetesClusters.Put(cluster.ID, cluster)
+		})
+	})
+
+	return cluster
+}
+
+func (f *fakeAPI) kubernetesVersion(id int) kubernetes.ClusterVersion {
+	for _, version := range f.kubernetesVersions {
+		if version.ID == id {
+			return version
+		}
+	}
+
+	return kubernetes.ClusterVersion{}
+}
+
+func fakeUpgradePath(version kubernetes.ClusterVersion, id int) (kubernetes.ClusterVersion, bool) {
+	for _, path := range version.UpgradePaths {
+		if path.ID == id {
+			return path, true
+		}
+	}
+
+	return kubernetes.ClusterVersion{}, false
+}
+
+// kubernetesNodes derives the nodes of the cluster from its current node count. The addresses are taken from the end
+// of the allocation pool to avoid collisions with other resources in the network.
+func (f *fakeAPI) kubernetesNodes(cluster kubernetes.Cluster) []kubernetes.Node {
+	healthy := kubernetes.NodeStatus{ID: compute.ApplicationStatusHealthy, Key: "healthy", Name: "Healthy"}
+	creating := kubernetes.NodeStatus{ID: compute.TaskStatusCreating, Key: "creating", Name: "Creating"}
+
+	groups := []struct {
+		role     kubernetes.NodeRole
+		product  common.Product
+		current  int
+		expected int
+	}{
+		{
+			role:     kubernetes.NodeRole{ID: 1, Key: "control-plane", Name: "Control Plane"},
+			product:  cluster.ExpectedPreset.ControlPlane,
+			current:  cluster.NodeCount.Current.ControlPlane,
+			expected: cluster.NodeCount.Expected.ControlPlane,
+		},
+		{
+			role:     kubernetes.NodeRole{ID: 2, Key: "worker", Name: "Worker"},
+			product:  cluster.ExpectedPreset.Worker,
+			current:  cluster.NodeCount.Current.Worker,
+			expected: cluster.NodeCount.Expected.Worker,
+		},
+	}
+
+	nodes := []kubernetes.Node{}
+	for _, group := range groups {
+		count := group.current
+		if group.expected > count {
+			count = group.expected
+		}
+
+		for i := 0; i < count; i++ {
+			index := len(nodes)
+
+			node := kubernetes.Node{
+				ID:      cluster.ID*100 + index,
+				Name:    fmt.Sprintf("%s-%s-%d", cluster.Name, group.role.Key, i+1),
+				Roles:   []kubernetes.NodeRole{group.role},
+				Product: group.product,
+				Network: compute.ServerNetworkAttachment{
+					Network: cluster.Network,
+					Interfaces: []compute.AttachedNetworkInterface{
+						{ID: cluster.ID*100 + index, PrivateIP: fakeAddress(cluster.Network.AllocationPoolEnd, -index)},
+					},
+				},
+				Status: healthy,
+			}
+
+			if i >= group.current {
+				node.Status = creating
+			}
+
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
@@ -0,0 +1,806 @@
+package flow
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/macbaremetal"
+)
+
+const (
+	fakeDeviceStatusRunning = iota + 1
+	fakeDeviceStatusStopped
+	fakeDeviceStatusStarting
+	fakeDeviceStatusStopping
+	fakeDeviceStatusInstalling
+	fakeDeviceStatusError
+)
+
+func fakeDeviceStatus(id int) macbaremetal.DeviceStatus {
+	statuses := map[int]macbaremetal.DeviceStatus{
+		fakeDeviceStatusRunning: {ID: fakeDeviceStatusRunning, Name: "Running", Key: "running", Actions: []macbaremetal.DeviceAction{
+			{ID: 1, Name: "Stop", Command: "stop", Sorting: 1},
+			{ID: 2, Name: "Restart", Command: "restart", Sorting: 2},
+		}},
+		fakeDeviceStatusStopped: {ID: fakeDeviceStatusStopped, Name: "Stopped", Key: "stopped", Actions: []macbaremetal.DeviceAction{
+			{ID: 3, Name: "Start", Command: "start", Sorting: 1},
+		}},
+		fakeDeviceStatusStarting:   {ID: fakeDeviceStatusStarting, Name: "Starting", Key: "starting", Actions: []macbaremetal.DeviceAction{}},
+		fakeDeviceStatusStopping:   {ID: fakeDeviceStatusStopping, Name: "Stopping", Key: "stopping", Actions: []macbaremetal.DeviceAction{}},
+		fakeDeviceStatusInstalling: {ID: fakeDeviceStatusInstalling, Name: "Installing", Key: "installing", Actions: []macbaremetal.DeviceAction{}},
+		fakeDeviceStatusError: {ID: fakeDeviceStatusError, Name: "Error", Key: "error", Actions: []macbaremetal.DeviceAction{
+			{ID: 3, Name: "Start", Command: "start", Sorting: 1},
+		}},
+	}
+
+	return statuses[id]
+}
+
+var fakeDeviceWorkflows = []macbaremetal.DeviceWorkflow{
+	{ID: 1, Name: "Reinstall", Command: "reinstall", Sorting: 1},
+	{ID: 2, Name: "Reset Password", Command: "reset-password", Sorting: 2},
+}
+
+func (f *fakeAPI) seedMacBareMetal() {
+	location, _ := f.location(2)
+
+	// the api currently only supports a single network per location, which is provisioned together with the location
+	f.createMacBareMetalNetwork("default", "", location)
+}
+
+func (f *fakeAPI) createMacBareMetalNetwork(name, description string, location common.Location) macbaremetal.Network {
+	cidr := fmt.Sprintf("10.%d.0.0/24", 100+len(f.macBareMetalNetworks.List()))
+	subnet, _ := parseFakeSubnet(cidr)
+
+	network := macbaremetal.Network{
+		ID:                  f.nextID(),
+		Name:                name,
+		Description:         description,
+		Subnet:              cidr,
+		Location:            location,
+		DomainNameServers:   []string{"1.1.1.1", "8.8.8.8"},
+		AllocationPoolStart: subnet.start,
+		AllocationPoolEnd:   subnet.end,
+		GatewayIP:           subnet.gateway,
+		TotalIPs:            subnet.total,
+	}
+	f.macBareMetalNetworks.Put(network.ID, network)
+
+	router := macbaremetal.Router{
+		ID:        f.nextID(),
+		Name:      fmt.Sprintf("%s-router", name),
+		Location:  location,
+		Public:    true,
+		SourceNAT: true,
+		PublicIP:  f.publicIP(),
+	}
+	f.macBareMetalRouters.Put(router.ID, router)
+
+	securityGroup := macbaremetal.SecurityGroup{
+		ID:          f.nextID(),
+		Name:        "default",
+		Description: "default security group",
+		Default:     true,
+		Network:     network,
+	}
+	f.macBareMetalSecurityGroups.Put(securityGroup.ID, securityGroup)
+
+	return network
+}
+
+func (f *fakeAPI) registerMacBareMetalRoutes() {
+	f.registerMacBareMetalNetworkRoutes()
+	f.registerMacBareMetalRouterRoutes()
+	f.registerMacBareMetalSecurityGroupRoutes()
+	f.registerMacBareMetalElasticIPRoutes()
+	f.registerMacBareMetalDeviceRoutes()
+}
+
+func (f *fakeAPI) registerMacBareMetalNetworkRoutes() {
+	f.handle(http.MethodGet, "/v4/macbaremetal/networks", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalNetworks.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/networks/{}", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalNetworks.Find("network", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/networks", func(r fakeRequest) (interface{}, error) {
+		var body macbaremetal.NetworkCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, network := range f.macBareMetalNetworks.List() {
+			if network.Location.ID == location.ID {
+				return nil, fakeConflict("location %d already has a network", location.ID)
+			}
+		}
+
+		return f.createMacBareMetalNetwork(body.Name, body.Description, location), nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/macbaremetal/networks/{}", func(r fakeRequest) (interface{}, error) {
+		network, err := f.macBareMetalNetworks.Find("network", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.NetworkUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		network.Name = fakeDefault(body.Name, network.Name)
+		network.Description = fakeDefault(body.Description, network.Description)
+		network.DomainName = fakeDefault(body.DomainName, network.DomainName)
+
+		if body.DomainNameServers != nil {
+			network.DomainNameServers = body.DomainNameServers
+		}
+
+		f.macBareMetalNetworks.Put(network.ID, network)
+		return network, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/macbaremetal/networks/{}", func(r fakeRequest) (interface{}, error) {
+		network, err := f.macBareMetalNetworks.Find("network", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, device := range f.macBareMetalDevices.List() {
+			if device.Network.ID == network.ID {
+				return nil, fakeConflict("network %d is still in use by device %d", network.ID, device.ID)
+			}
+		}
+
+		for _, securityGroup := range f.macBareMetalSecurityGroups.List() {
+			if securityGroup.Network.ID == network.ID && !securityGroup.Default {
+				return nil, fakeConflict("network %d is still in use by security group %d", network.ID, securityGroup.ID)
+			}
+		}
+
+		for _, securityGroup := range f.macBareMetalSecurityGroups.List() {
+			if securityGroup.Network.ID == network.ID {
+				f.macBareMetalSecurityGroups.Delete(securityGroup.ID)
+			}
+		}
+
+		if router, ok := f.macBareMetalRouter(network.ID); ok {
+			f.macBareMetalRouters.Delete(router.ID)
+		}
+
+		f.macBareMetalNetworks.Delete(network.ID)
+		return nil, nil
+	})
+}
+
+// macBareMetalRouter returns the router connected to the network. Routers are provisioned together with their network
+// and thus share the location with it.
+func (f *fakeAPI) macBareMetalRouter(networkID int) (macbaremetal.Router, bool) {
+	network, ok := f.macBareMetalNetworks.Get(networkID)
+	if !ok {
+		return macbaremetal.Router{}, false
+	}
+
+	for _, router := range f.macBareMetalRouters.List() {
+		if router.Location.ID == network.Location.ID {
+			return router, true
+		}
+	}
+
+	return macbaremetal.Router{}, false
+}
+
+func (f *fakeAPI) registerMacBareMetalRouterRoutes() {
+	f.handle(http.MethodGet, "/v4/macbaremetal/routers", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalRouters.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/routers/{}", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalRouters.Find("router", r.param(0))
+	})
+
+	f.handle(http.MethodPatch, "/v4/macbaremetal/routers/{}", func(r fakeRequest) (interface{}, error) {
+		router, err := f.macBareMetalRouters.Find("router", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.RouterUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		router.Name = body.Name
+		router.Description = body.Description
+
+		f.macBareMetalRouters.Put(router.ID, router)
+		return router, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/routers/{}/router-interfaces", func(r fakeRequest) (interface{}, error) {
+		router, err := f.macBareMetalRouters.Find("router", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		routerInterfaces := []macbaremetal.RouterInterface{}
+		for _, network := range f.macBareMetalNetworks.List() {
+			if network.Location.ID == router.Location.ID {
+				routerInterfaces = append(routerInterfaces, macbaremetal.RouterInterface{
+					ID:        router.ID*100 + len(routerInterfaces),
+					PrivateIP: network.GatewayIP,
+					Network:   network,
+				})
+			}
+		}
+
+		return routerInterfaces, nil
+	})
+}
+
+func (f *fakeAPI) registerMacBareMetalSecurityGroupRoutes() {
+	f.handle(http.MethodGet, "/v4/macbaremetal/security-groups", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalSecurityGroups.List(), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/security-groups/{}", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalSecurityGroups.Find("security group", r.param(0))
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/security-groups", func(r fakeRequest) (interface{}, error) {
+		var body macbaremetal.SecurityGroupCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		network, err := f.macBareMetalNetworks.Find("network", body.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		securityGroup := macbaremetal.SecurityGroup{
+			ID:          f.nextID(),
+			Name:        body.Name,
+			Description: body.Description,
+			Network:     network,
+		}
+
+		f.macBareMetalSecurityGroups.Put(securityGroup.ID, securityGroup)
+		return securityGroup, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/macbaremetal/security-groups/{}", func(r fakeRequest) (interface{}, error) {
+		securityGroup, err := f.macBareMetalSecurityGroups.Find("security group", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.SecurityGroupUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		securityGroup.Name = body.Name
+		securityGroup.Description = body.Description
+
+		f.macBareMetalSecurityGroups.Put(securityGroup.ID, securityGroup)
+		return securityGroup, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/macbaremetal/security-groups/{}", func(r fakeRequest) (interface{}, error) {
+		securityGroup, err := f.macBareMetalSecurityGroups.Find("security group", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if securityGroup.Default {
+			return nil, fakeConflict("the default security group can not be deleted")
+		}
+
+		for _, interfaces := range f.macBareMetalNetworkInterfaces {
+			for _, networkInterface := range interfaces.List() {
+				if networkInterface.SecurityGroup.ID == securityGroup.ID {
+					return nil, fakeConflict("security group %d is still in use", securityGroup.ID)
+				}
+			}
+		}
+
+		f.macBareMetalSecurityGroups.Delete(securityGroup.ID)
+		delete(f.macBareMetalSecurityGroupRules, securityGroup.ID)
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/security-groups/{}/rules", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.macBareMetalSecurityGroups.Find("security group", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.macBareMetalSecurityGroupRules, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/security-groups/{}/rules", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.macBareMetalSecurityGroups.Find("security group", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.SecurityGroupRuleOptions
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		rule, err := fakeMacBareMetalSecurityGroupRule(f.nextID(), body)
+		if err != nil {
+			return nil, err
+		}
+
+		fakeChildren(f.macBareMetalSecurityGroupRules, r.param(0)).Put(rule.ID, rule)
+		return rule, nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/macbaremetal/security-groups/{}/rules/{}", func(r fakeRequest) (interface{}, error) {
+		rules := fakeChildren(f.macBareMetalSecurityGroupRules, r.param(0))
+		if _, err := rules.Find("security group rule", r.param(1)); err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.SecurityGroupRuleOptions
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		rule, err := fakeMacBareMetalSecurityGroupRule(r.param(1), body)
+		if err != nil {
+			return nil, err
+		}
+
+		rules.Put(rule.ID, rule)
+		return rule, nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/macbaremetal/security-groups/{}/rules/{}", func(r fakeRequest) (interface{}, error) {
+		if !fakeChildren(f.macBareMetalSecurityGroupRules, r.param(0)).Delete(r.param(1)) {
+			return nil, fakeNotFound("security group rule", r.param(1))
+		}
+
+		return nil, nil
+	})
+}
+
+func fakeMacBareMetalSecurityGroupRule(id int, options macbaremetal.SecurityGroupRuleOptions) (macbaremetal.SecurityGroupRule, error) {
+	if options.Direction != macbaremetal.DirectionIngress && options.Direction != macbaremetal.DirectionEgress {
+		return macbaremetal.SecurityGroupRule{}, fmt.Errorf("invalid direction %q", options.Direction)
+	}
+
+	return macbaremetal.SecurityGroupRule{
+		ID:        id,
+		Direction: options.Direction,
+		Protocol:  options.Protocol,
+		FromPort:  options.FromPort,
+		ToPort:    options.ToPort,
+		ICMPType:  options.ICMPType,
+		ICMPCode:  options.ICMPCode,
+		IPRange:   options.IPRange,
+	}, nil
+}
+
+func (f *fakeAPI) registerMacBareMetalElasticIPRoutes() {
+	f.handle(http.MethodGet, "/v4/macbaremetal/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		return f.macBareMetalElasticIPs.List(), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		var body macbaremetal.ElasticIPCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		return f.createMacBareMetalElasticIP(location), nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/macbaremetal/elastic-ips/{}", func(r fakeRequest) (interface{}, error) {
+		elasticIP, err := f.macBareMetalElasticIPs.Find("elastic ip", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		if elasticIP.Attachment.ID != 0 {
+			return nil, fakeConflict("elastic ip %d is still attached to device %d", elasticIP.ID, elasticIP.Attachment.ID)
+		}
+
+		f.macBareMetalElasticIPs.Delete(elasticIP.ID)
+		return nil, nil
+	})
+}
+
+func (f *fakeAPI) createMacBareMetalElasticIP(location common.Location) macbaremetal.ElasticIP {
+	product := f.productByType("bare-metal-network-elastic-ip")
+
+	elasticIP := macbaremetal.ElasticIP{
+		ID:       f.nextID(),
+		Product:  common.BriefProduct{ID: product.ID, Name: product.Name, Type: product.Type.Key},
+		Location: location,
+		PublicIP: f.publicIP(),
+	}
+
+	f.macBareMetalElasticIPs.Put(elasticIP.ID, elasticIP)
+	return elasticIP
+}
+
+func (f *fakeAPI) registerMacBareMetalDeviceRoutes() {
+	f.handle(http.MethodGet, "/v4/macbaremetal/devices", func(r fakeRequest) (interface{}, error) {
+		var devices []macbaremetal.Device
+		for _, device := range f.macBareMetalDevices.List() {
+			devices = append(devices, f.renderMacBareMetalDevice(device))
+		}
+
+		return fakeList(devices), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/devices/{}", func(r fakeRequest) (interface{}, error) {
+		f.advance("device", r.param(0))
+
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		return f.renderMacBareMetalDevice(device), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/devices", func(r fakeRequest) (interface{}, error) {
+		var body macbaremetal.DeviceCreate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		location, err := f.location(body.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		product, err := f.product(body.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		network, err := f.macBareMetalNetworks.Find("network", body.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		if network.Location.ID != location.ID {
+			return nil, fmt.Errorf("network %d is not in location %d", network.ID, location.ID)
+		}
+
+		return f.order(body.Name, func() int {
+			device := macbaremetal.Device{
+				ID:              f.nextID(),
+				Name:            body.Name,
+				Location:        location,
+				Product:         product,
+				Status:          fakeDeviceStatus(fakeDeviceStatusRunning),
+				OperatingSystem: macbaremetal.DeviceOperatingSystem{OS: "macOS", Name: "Monterey", Version: "12"},
+				Network:         network,
+				Hostname:        body.Name,
+			}
+
+			device.MetalControl = fmt.Sprintf("https://metal-control.example.com/devices/%d", device.ID)
+			f.macBareMetalDevices.Put(device.ID, device)
+
+			networkInterface := f.createMacBareMetalNetworkInterface(device)
+			if body.AttachElasticIP {
+				f.attachMacBareMetalElasticIP(device, networkInterface, f.createMacBareMetalElasticIP(location))
+			}
+
+			return device.ID
+		}), nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/macbaremetal/devices/{}", func(r fakeRequest) (interface{}, error) {
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.DeviceUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		device.Name = fakeDefault(body.Name, device.Name)
+
+		f.macBareMetalDevices.Put(device.ID, device)
+		return f.renderMacBareMetalDevice(device), nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/macbaremetal/devices/{}", func(r fakeRequest) (interface{}, error) {
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		// devices are deleted asynchronously and remain visible until they have been torn down
+		f.schedule("device", device.ID, func() {
+			for _, networkInterface := range fakeChildren(f.macBareMetalNetworkInterfaces, device.ID).List() {
+				f.detachMacBareMetalElasticIP(device.ID, networkInterface)
+			}
+
+			delete(f.macBareMetalNetworkInterfaces, device.ID)
+			f.macBareMetalDevices.Delete(device.ID)
+		})
+
+		return nil, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/devices/{}/vnc", func(r fakeRequest) (interface{}, error) {
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		return macbaremetal.DeviceVNCConnection{
+			Ref: fmt.Sprintf("https://vnc.example.com/devices/%d?token=fake-vnc-token-%d", device.ID, device.ID),
+		}, nil
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/devices/{}/actions", func(r fakeRequest) (interface{}, error) {
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.DeviceRunAction
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		allowed := false
+		for _, action := range device.Status.Actions {
+			allowed = allowed || action.Command == body.Action
+		}
+
+		if !allowed {
+			return nil, fakeConflict("action %q is not available in status %s", body.Action, device.Status.Key)
+		}
+
+		transitional, final := fakeDeviceStatusStarting, fakeDeviceStatusRunning
+		if body.Action == "stop" {
+			transitional, final = fakeDeviceStatusStopping, fakeDeviceStatusStopped
+		}
+
+		return f.changeMacBareMetalDevice(device, transitional, final), nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/devices/{}/workflows", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.macBareMetalDevices.Find("device", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeDeviceWorkflows, nil
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/devices/{}/workflows", func(r fakeRequest) (interface{}, error) {
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.DeviceRunWorkflow
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		if f.pending("device", device.ID) {
+			return nil, fakeConflict("device %d is busy", device.ID)
+		}
+
+		switch body.Workflow {
+		case "reinstall":
+			return f.changeMacBareMetalDevice(device, fakeDeviceStatusInstalling, fakeDeviceStatusRunning), nil
+		case "reset-password":
+			return f.renderMacBareMetalDevice(device), nil
+		default:
+			return nil, fmt.Errorf("unknown workflow %q", body.Workflow)
+		}
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/devices/{}/network-interfaces", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.macBareMetalDevices.Find("device", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		return fakeChildren(f.macBareMetalNetworkInterfaces, r.param(0)).List(), nil
+	})
+
+	f.handle(http.MethodPatch, "/v4/macbaremetal/devices/{}/network-interfaces/{}", func(r fakeRequest) (interface{}, error) {
+		interfaces := fakeChildren(f.macBareMetalNetworkInterfaces, r.param(0))
+
+		networkInterface, err := interfaces.Find("network interface", r.param(1))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.NetworkInterfaceSecurityGroupUpdate
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		securityGroup, err := f.macBareMetalSecurityGroups.Find("security group", body.SecurityGroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		networkInterface.SecurityGroup = securityGroup
+
+		interfaces.Put(networkInterface.ID, networkInterface)
+		return networkInterface, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/macbaremetal/devices/{}/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		if _, err := f.macBareMetalDevices.Find("device", r.param(0)); err != nil {
+			return nil, err
+		}
+
+		var elasticIPs []macbaremetal.ElasticIP
+		for _, elasticIP := range f.macBareMetalElasticIPs.List() {
+			if elasticIP.Attachment.ID == r.param(0) {
+				elasticIPs = append(elasticIPs, elasticIP)
+			}
+		}
+
+		return fakeList(elasticIPs), nil
+	})
+
+	f.handle(http.MethodPost, "/v4/macbaremetal/devices/{}/elastic-ips", func(r fakeRequest) (interface{}, error) {
+		device, err := f.macBareMetalDevices.Find("device", r.param(0))
+		if err != nil {
+			return nil, err
+		}
+
+		var body macbaremetal.ElasticIPAttach
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		elasticIP, err := f.macBareMetalElasticIPs.Find("elastic ip", body.ElasticIPID)
+		if err != nil {
+			return nil, err
+		}
+
+		networkInterface, err := fakeChildren(f.macBareMetalNetworkInterfaces, device.ID).Find("network interface", body.NetworkInterfaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if elasticIP.Attachment.ID != 0 {
+			return nil, fakeConflict("elastic ip %d is already attached", elasticIP.ID)
+		}
+
+		if networkInterface.AttachedElasticIP.ID != 0 {
+			return nil, fakeConflict("network interface %d already has an elastic ip attached", networkInterface.ID)
+		}
+
+		return f.attachMacBareMetalElasticIP(device, networkInterface, elasticIP), nil
+	})
+
+	f.handle(http.MethodDelete, "/v4/macbaremetal/devices/{}/elastic-ips/{}", func(r fakeRequest) (interface{}, error) {
+		for _, networkInterface := range fakeChildren(f.macBareMetalNetworkInterfaces, r.param(0)).List() {
+			if networkInterface.AttachedElasticIP.ID == r.param(1) {
+				f.detachMacBareMetalElasticIP(r.param(0), networkInterface)
+				return nil, nil
+			}
+		}
+
+		return nil, fakeNotFound("elastic ip", r.param(1))
+	})
+}
+
+// renderMacBareMetalDevice fills in the attached network interfaces of the device.
+func (f *fakeAPI) renderMacBareMetalDevice(device macbaremetal.Device) macbaremetal.Device {
+	device.NetworkInterfaces = []macbaremetal.AttachedNetworkInterface{}
+
+	for _, networkInterface := range fakeChildren(f.macBareMetalNetworkInterfaces, device.ID).List() {
+		device.NetworkInterfaces = append(device.NetworkInterfaces, macbaremetal.AttachedNetworkInterface{
+			ID:        networkInterface.ID,
+			PrivateIP: networkInterface.PrivateIP,
+			PublicIP:  networkInterface.AttachedElasticIP.PublicIP,
+		})
+	}
+
+	return device
+}
+
+// changeMacBareMetalDevice puts the device into a transitional status until it has been polled a few times. Delayed
+// devices keep their status for a few polls before entering the transitional status.
+func (f *fakeAPI) changeMacBareMetalDevice(device macbaremetal.Device, transitional, final int) macbaremetal.Device {
+	if f.failingDevices[device.Name] {
+		final = fakeDeviceStatusError
+	}
+
+	change := func() {
+		device, _ := f.macBareMetalDevices.Get(device.ID)
+		device.Status = fakeDeviceStatus(transitional)
+		f.macBareMetalDevices.Put(device.ID, device)
+
+		f.schedule("device", device.ID, func() {
+			device, _ := f.macBareMetalDevices.Get(device.ID)
+			device.Status = fakeDeviceStatus(final)
+			f.macBareMetalDevices.Put(device.ID, device)
+		})
+	}
+
+	if f.delayedDevices[device.Name] {
+		f.schedule("device", device.ID, change)
+	} else {
+		change()
+	}
+
+	device, _ = f.macBareMetalDevices.Get(device.ID)
+	return f.renderMacBareMetalDevice(device)
+}
+
+func (f *fakeAPI) createMacBareMetalNetworkInterface(device macbaremetal.Device) macbaremetal.NetworkInterface {
+	network, _ := f.macBareMetalNetworks.Get(device.Network.ID)
+	network.UsedIPs++
+	f.macBareMetalNetworks.Put(network.ID, network)
+
+	var securityGroup macbaremetal.SecurityGroup
+	for _, candidate := range f.macBareMetalSecurityGroups.List() {
+		if candidate.Default && candidate.Network.ID == network.ID {
+			securityGroup = candidate
+		}
+	}
+
+	id := f.nextID()
+	networkInterface := macbaremetal.NetworkInterface{
+		ID:            id,
+		PrivateIP:     fakeAddress(network.AllocationPoolStart, network.UsedIPs-1),
+		MacAddress:    fmt.Sprintf("a4:83:e7:%02x:%02x:%02x", (id>>16)&0xff, (id>>8)&0xff, id&0xff),
+		Network:       network,
+		SecurityGroup: securityGroup,
+	}
+
+	fakeChildren(f.macBareMetalNetworkInterfaces, device.ID).Put(networkInterface.ID, networkInterface)
+	return networkInterface
+}
+
+func (f *fakeAPI) attachMacBareMetalElasticIP(device macbaremetal.Device, networkInterface macbaremetal.NetworkInterface, elasticIP macbaremetal.ElasticIP) macbaremetal.ElasticIP {
+	elasticIP.PrivateIP = networkInterface.PrivateIP
+	elasticIP.Attachment = macbaremetal.ElasticIPAttachment{ID: device.ID, Name: device.Name, Type: "device"}
+	f.macBareMetalElasticIPs.Put(elasticIP.ID, elasticIP)
+
+	networkInterface.AttachedElasticIP = elasticIP
+	fakeChildren(f.macBareMetalNetworkInterfaces, device.ID).Put(networkInterface.ID, networkInterface)
+
+	return elasticIP
+}
+
+func (f *fakeAPI) detachMacBareMetalElasticIP(deviceID int, networkInterface macbaremetal.NetworkInterface) {
+	if networkInterface.AttachedElasticIP.ID == 0 {
+		return
+	}
+
+	elasticIP, ok := f.macBareMetalElasticIPs.Get(networkInterface.AttachedElasticIP.ID)
+	if ok {
+		elasticIP.PrivateIP = ""
+		elasticIP.Attachment = macbaremetal.ElasticIPAttachment{}
+		f.macBareMetalElasticIPs.Put(elasticIP.ID, elasticIP)
+	}
+
+	networkInterface.AttachedElasticIP = macbaremetal.ElasticIP{}
+	fakeChildren(f.macBareMetalNetworkInterfaces, deviceID).Put(networkInterface.ID, networkInterface)
+}
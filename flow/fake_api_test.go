@@ -0,0 +1,766 @@
+package flow
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/flowswiss/goclient/kubernetes"
+	"github.com/flowswiss/goclient/macbaremetal"
+)
+
+const (
+	fakeAPIToken = "fake-token"
+
+	// fakeAPIPendingPolls is the number of times an asynchronous operation (e.g. an order) has to be polled before it
+	// completes.
+	fakeAPIPendingPolls = 2
+)
+
+// fakeAPI is an in-memory implementation of the parts of the flow api used by the provider. It allows running the
+// acceptance tests without network access or a flow account.
+type fakeAPI struct {
+	token  string
+	routes []fakeRoute
+
+	mu           sync.Mutex
+	lastID       int
+	lastPublicIP int
+	transitions  map[string]*fakeTransition
+
+	// heldOrders contains the names of the entities whose orders are kept processing until they are released. This
+	// allows tests to interrupt waiting for an order without depending on the timing of the requests.
+	heldOrders map[string]bool
+	orderNames map[int]string
+
+	// failingDevices contains the names of the mac bare metal devices whose actions and workflows end in an error.
+	failingDevices map[string]bool
+
+	// delayedDevices contains the names of the mac bare metal devices whose actions and workflows only begin after the
+	// device has been polled a few times, like on the real api where the status does not change with the request.
+	delayedDevices map[string]bool
+
+	locations []common.Location
+	modules   []common.Module
+	products  []common.Product
+	orders    *fakeCollection[common.Order]
+
+	images                   []compute.Image
+	loadBalancerAlgorithms   []compute.LoadBalancerAlgorithm
+	loadBalancerProtocols    []compute.LoadBalancerProtocol
+	loadBalancerHealthChecks []compute.LoadBalancerHealthCheckType
+
+	computeNetworks            *fakeCollection[compute.Network]
+	computeKeyPairs            *fakeCollection[compute.KeyPair]
+	computeCertificates        *fakeCollection[compute.Certificate]
+	computeElasticIPs          *fakeCollection[compute.ElasticIP]
+	computeRouters             *fakeCollection[compute.Router]
+	computeRouterInterfaces    map[int]*fakeCollection[compute.RouterInterface]
+	computeRoutes              map[int]*fakeCollection[compute.Route]
+	computeSecurityGroups      *fakeCollection[compute.SecurityGroup]
+	computeSecurityGroupRules  map[int]*fakeCollection[compute.SecurityGroupRule]
+	computeServers             *fakeCollection[compute.Server]
+	computeNetworkInterfaces   map[int]*fakeCollection[compute.NetworkInterface]
+	computeVolumes             *fakeCollection[compute.Volume]
+	computeSnapshots           *fakeCollection[compute.Snapshot]
+	computeLoadBalancers       *fakeCollection[compute.LoadBalancer]
+	computeLoadBalancerPools   map[int]*fakeCollection[compute.LoadBalancerPool]
+	computeLoadBalancerMembers map[int]*fakeCollection[compute.LoadBalancerMember]
+
+	kubernetesClusters       *fakeCollection[kubernetes.Cluster]
+	kubernetesConfigurations map[int]kubernetes.ClusterConfiguration
+	kubernetesVersions       []kubernetes.ClusterVersion
+
+	macBareMetalNetworks           *fakeCollection[macbaremetal.Network]
+	macBareMetalRouters            *fakeCollection[macbaremetal.Router]
+	macBareMetalSecurityGroups     *fakeCollection[macbaremetal.SecurityGroup]
+	macBareMetalSecurityGroupRules map[int]*fakeCollection[macbaremetal.SecurityGroupRule]
+	macBareMetalElasticIPs         *fakeCollection[macbaremetal.ElasticIP]
+	macBareMetalDevices            *fakeCollection[macbaremetal.Device]
+	macBareMetalNetworkInterfaces  map[int]*fakeCollection[macbaremetal.NetworkInterface]
+}
+
+func newFakeAPI(token string) *fakeAPI {
+	f := &fakeAPI{
+		token:          token,
+		lastID:         1000,
+		transitions:    map[string]*fakeTransition{},
+		heldOrders:     map[string]bool{},
+		orderNames:     map[int]string{},
+		failingDevices: map[string]bool{},
+		delayedDevices: map[string]bool{},
+		orders:         newFakeCollection[common.Order](),
+
+		computeNetworks:            newFakeCollection[compute.Network](),
+		computeKeyPairs:            newFakeCollection[compute.KeyPair](),
+		computeCertificates:        newFakeCollection[compute.Certificate](),
+		computeElasticIPs:          newFakeCollection[compute.ElasticIP](),
+		computeRouters:             newFakeCollection[compute.Router](),
+		computeRouterInterfaces:    map[int]*fakeCollection[compute.RouterInterface]{},
+		computeRoutes:              map[int]*fakeCollection[compute.Route]{},
+		computeSecurityGroups:      newFakeCollection[compute.SecurityGroup](),
+		computeSecurityGroupRules:  map[int]*fakeCollection[compute.SecurityGroupRule]{},
+		computeServers:             newFakeCollection[compute.Server](),
+		computeNetworkInterfaces:   map[int]*fakeCollection[compute.NetworkInterface]{},
+		computeVolumes:             newFakeCollection[compute.Volume](),
+		computeSnapshots:           newFakeCollection[compute.Snapshot](),
+		computeLoadBalancers:       newFakeCollection[compute.LoadBalancer](),
+		computeLoadBalancerPools:   map[int]*fakeCollection[compute.LoadBalancerPool]{},
+		computeLoadBalancerMembers: map[int]*fakeCollection[compute.LoadBalancerMember]{},
+
+		kubernetesClusters:       newFakeCollection[kubernetes.Cluster](),
+		kubernetesConfigurations: map[int]kubernetes.ClusterConfiguration{},
+
+		macBareMetalNetworks:           newFakeCollection[macbaremetal.Network](),
+		macBareMetalRouters:            newFakeCollection[macbaremetal.Router](),
+		macBareMetalSecurityGroups:     newFakeCollection[macbaremetal.SecurityGroup](),
+		macBareMetalSecurityGroupRules: map[int]*fakeCollection[macbaremetal.SecurityGroupRule]{},
+		macBareMetalElasticIPs:         newFakeCollection[macbaremetal.ElasticIP](),
+		macBareMetalDevices:            newFakeCollection[macbaremetal.Device](),
+		macBareMetalNetworkInterfaces:  map[int]*fakeCollection[macbaremetal.NetworkInterface]{},
+	}
+
+	f.registerCommonRoutes()
+	f.registerComputeRoutes()
+	f.registerKubernetesRoutes()
+	f.registerMacBareMetalRoutes()
+
+	f.seedCommon()
+	f.seedCompute()
+	f.seedKubernetes()
+	f.seedMacBareMetal()
+
+	return f
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+f.token {
+		writeFakeResponse(w, nil, fakeError{status: http.StatusUnauthorized, message: "invalid authentication token"})
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for _, route := range f.routes {
+		params, ok := route.match(r.Method, segments)
+		if !ok {
+			continue
+		}
+
+		f.mu.Lock()
+		body, err := route.handler(fakeRequest{Request: r, params: params})
+		f.mu.Unlock()
+
+		writeFakeResponse(w, body, err)
+		return
+	}
+
+	// unknown routes must not be mistaken for deleted entities, which would hide requests to a wrong url
+	writeFakeResponse(w, nil, fakeError{status: http.StatusNotImplemented, message: fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path)})
+}
+
+func writeFakeResponse(w http.ResponseWriter, body interface{}, err error) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err != nil {
+		apiErr, ok := err.(fakeError)
+		if !ok {
+			apiErr = fakeError{status: http.StatusBadRequest, message: err.Error()}
+		}
+
+		w.WriteHeader(apiErr.status)
+
+		res := map[string]interface{}{}
+		res["error"] = map[string]interface{}{"message": map[string]string{"en": apiErr.message}}
+		_ = json.NewEncoder(w).Encode(res)
+		return
+	}
+
+	if body == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+type fakeError struct {
+	status  int
+	message string
+}
+
+func (e fakeError) Error() string {
+	return e.message
+}
+
+func fakeNotFound(kind string, id int) error {
+	return fakeError{status: http.StatusNotFound, message: fmt.Sprintf("%s %d not found", kind, id)}
+}
+
+func fakeConflict(format string, args ...interface{}) error {
+	return fakeError{status: http.StatusConflict, message: fmt.Sprintf(format, args...)}
+}
+
+type fakeHandler func(r fakeRequest) (interface{}, error)
+
+type fakeRoute struct {
+	method  string
+	pattern []string
+	handler fakeHandler
+}
+
+// match checks whether the route applies to the given request and extracts the numeric path parameters, which are
+// denoted as "{}" in the pattern.
+func (r fakeRoute) match(method string, segments []string) ([]int, bool) {
+	if r.method != method || len(r.pattern) != len(segments) {
+		return nil, false
+	}
+
+	var params []int
+	for i, segment := range r.pattern {
+		if segment != "{}" {
+			if segment != segments[i] {
+				return nil, false
+			}
+
+			continue
+		}
+
+		param, err := strconv.Atoi(segments[i])
+		if err != nil {
+			return nil, false
+		}
+
+		params = append(params, param)
+	}
+
+	return params, true
+}
+
+func (f *fakeAPI) handle(method string, pattern string, handler fakeHandler) {
+	f.routes = append(f.routes, fakeRoute{
+		method:  method,
+		pattern: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler: handler,
+	})
+}
+
+type fakeRequest struct {
+	*http.Request
+	params []int
+}
+
+func (r fakeRequest) param(i int) int {
+	return r.params[i]
+}
+
+func (r fakeRequest) decode(v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fakeError{status: http.StatusBadRequest, message: fmt.Sprintf("invalid request body: %s", err)}
+	}
+
+	return nil
+}
+
+// fakeCollection stores the entities of a single type by their id.
+type fakeCollection[T any] struct {
+	items map[int]T
+}
+
+func newFakeCollection[T any]() *fakeCollection[T] {
+	return &fakeCollection[T]{items: map[int]T{}}
+}
+
+func fakeChildren[T any](parents map[int]*fakeCollection[T], parentID int) *fakeCollection[T] {
+	children, ok := parents[parentID]
+	if !ok {
+		children = newFakeCollection[T]()
+		parents[parentID] = children
+	}
+
+	return children
+}
+
+func (c *fakeCollection[T]) Get(id int) (T, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+// Find returns the entity with the given id or a not found error mentioning the kind of the entity.
+func (c *fakeCollection[T]) Find(kind string, id int) (T, error) {
+	item, ok := c.items[id]
+	if !ok {
+		return item, fakeNotFound(kind, id)
+	}
+
+	return item, nil
+}
+
+func (c *fakeCollection[T]) Put(id int, item T) {
+	c.items[id] = item
+}
+
+func (c *fakeCollection[T]) Delete(id int) bool {
+	_, ok := c.items[id]
+	delete(c.items, id)
+	return ok
+}
+
+func (c *fakeCollection[T]) List() []T {
+	ids := make([]int, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	items := make([]T, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, c.items[id])
+	}
+
+	return items
+}
+
+// fakeList makes sure that empty lists are encoded as an empty array instead of null.
+func fakeList[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	return items
+}
+
+func fakeDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func fakeDefaultInt(value, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+
+	return value
+}
+
+func (f *fakeAPI) nextID() int {
+	f.lastID++
+	return f.lastID
+}
+
+// publicIP allocates a new public ip address from the benchmarking range.
+func (f *fakeAPI) publicIP() string {
+	f.lastPublicIP++
+	return fakeAddress("198.18.0.0", f.lastPublicIP)
+}
+
+// fakeSubnet holds the addresses of an ipv4 network as assigned by the api.
+type fakeSubnet struct {
+	gateway string
+	start   string
+	end     string
+	total   int
+}
+
+func parseFakeSubnet(cidr string) (fakeSubnet, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fakeSubnet{}, fmt.Errorf("invalid cidr %q", cidr)
+	}
+
+	ones, bits := network.Mask.Size()
+	if network.IP.To4() == nil || bits-ones < 3 {
+		return fakeSubnet{}, fmt.Errorf("cidr %q must be an ipv4 network with at least 8 addresses", cidr)
+	}
+
+	base := network.IP.String()
+	size := 1 << (bits - ones)
+
+	return fakeSubnet{
+		gateway: fakeAddress(base, 1),
+		start:   fakeAddress(base, 2),
+		end:     fakeAddress(base, size-2),
+		total:   size - 3,
+	}, nil
+}
+
+// fakeAddress returns the ipv4 address at the given offset from base.
+func fakeAddress(base string, offset int) string {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(net.ParseIP(base).To4())+uint32(offset))
+	return ip.String()
+}
+
+// fakeTransition is a pending state change of an entity which is applied after the entity has been polled a few times.
+type fakeTransition struct {
+	polls int
+	apply func()
+}
+
+func fakeTransitionKey(kind string, id int) string {
+	return fmt.Sprintf("%s/%d", kind, id)
+}
+
+func (f *fakeAPI) schedule(kind string, id int, apply func()) {
+	f.transitions[fakeTransitionKey(kind, id)] = &fakeTransition{
+		polls: fakeAPIPendingPolls,
+		apply: apply,
+	}
+}
+
+func (f *fakeAPI) pending(kind string, id int) bool {
+	_, ok := f.transitions[fakeTransitionKey(kind, id)]
+	return ok
+}
+
+// advance is called whenever an entity is polled and applies its pending transition once it is due.
+func (f *fakeAPI) advance(kind string, id int) {
+	key := fakeTransitionKey(kind, id)
+
+	transition, ok := f.transitions[key]
+	if !ok {
+		return
+	}
+
+	transition.polls--
+	if transition.polls > 0 {
+		return
+	}
+
+	delete(f.transitions, key)
+	transition.apply()
+}
+
+// order places an asynchronous order for an entity with the given name. The create function is called once the order
+// has been processed and returns the id of the created entity.
+func (f *fakeAPI) order(name string, create func() int) common.Ordering {
+	id := f.nextID()
+	f.orderNames[id] = name
+	f.orders.Put(id, common.Order{
+		ID:     id,
+		Status: common.OrderStatus{ID: common.OrderStatusCreated, Name: "Created"},
+	})
+
+	f.schedule("order", id, func() {
+		order, _ := f.orders.Get(id)
+		order.Status = common.OrderStatus{ID: common.OrderStatusSucceeded, Name: "Succeeded"}
+		order.Product = common.Product{ID: create()}
+		f.orders.Put(id, order)
+	})
+
+	return common.Ordering{Ref: fmt.Sprintf("/v4/orders/%d", id)}
+}
+
+// holdOrders keeps the orders of entities with the given name processing until releaseOrders is called.
+func (f *fakeAPI) holdOrders(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.heldOrders[name] = true
+}
+
+func (f *fakeAPI) releaseOrders(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.heldOrders, name)
+}
+
+func (f *fakeAPI) failDevices(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failingDevices[name] = true
+}
+
+func (f *fakeAPI) delayDevices(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.delayedDevices[name] = true
+}
+
+// settled reports whether the entity has no pending transition.
+func (f *fakeAPI) settled(kind string, id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return !f.pending(kind, id)
+}
+
+func (f *fakeAPI) location(id int) (common.Location, error) {
+	for _, location := range f.locations {
+		if location.ID == id {
+			return location, nil
+		}
+	}
+
+	return common.Location{}, fakeNotFound("location", id)
+}
+
+func (f *fakeAPI) product(id int) (common.Product, error) {
+	for _, product := range f.products {
+		if product.ID == id {
+			return product, nil
+		}
+	}
+
+	return common.Product{}, fakeNotFound("product", id)
+}
+
+func (f *fakeAPI) productByType(typeKey string) common.Product {
+	for _, product := range f.products {
+		if product.Type.Key == typeKey {
+			return product
+		}
+	}
+
+	panic(fmt.Sprintf("fake api has no product of type %s", typeKey))
+}
+
+func (f *fakeAPI) seedCommon() {
+	computeModule := common.Module{ID: 2, Name: "Compute"}
+	macBareMetalModule := common.Module{ID: 3, Name: "Mac Bare Metal"}
+	kubernetesModule := common.Module{ID: 4, Name: "Kubernetes"}
+
+	f.locations = []common.Location{
+		{ID: 1, Name: "ALP1", Key: "ALP1", City: "Lucerne", Modules: []common.Module{computeModule, kubernetesModule}},
+		{ID: 2, Name: "ZRH1", Key: "ZRH1", City: "Zurich", Modules: []common.Module{macBareMetalModule}},
+	}
+
+	for _, module := range []common.Module{computeModule, macBareMetalModule, kubernetesModule} {
+		for _, location := range f.locations {
+			for _, available := range location.Modules {
+				if available.ID == module.ID {
+					module.Locations = append(module.Locations, common.Location{ID: location.ID, Name: location.Name, Key: location.Key, City: location.City})
+				}
+			}
+		}
+
+		f.modules = append(f.modules, module)
+	}
+
+	productType := func(id int, key, name string) common.ProductType {
+		return common.ProductType{ID: id, Key: key, Name: name}
+	}
+
+	serverType := productType(1, "compute-engine-vm", "Compute Engine")
+	volumeType := productType(2, "compute-storage-volume", "Volume")
+	snapshotType := productType(3, "compute-storage-snapshot", "Snapshot")
+	elasticIPType := productType(4, "compute-network-elastic-ip", "Elastic IP")
+	loadBalancerType := productType(5, "compute-network-load-balancer", "Load Balancer")
+	kubernetesType := productType(6, "kubernetes-cluster", "Kubernetes Cluster")
+	kubernetesNodeType := productType(7, "kubernetes-node", "Kubernetes Node")
+	macBareMetalType := productType(8, "bare-metal-device", "Mac Bare Metal")
+	macBareMetalElasticIPType := productType(9, "bare-metal-network-elastic-ip", "Mac Bare Metal Elastic IP")
+
+	availability := func(locations ...int) (result []common.ProductAvailability) {
+		for _, id := range locations {
+			location, _ := f.location(id)
+			result = append(result, common.ProductAvailability{Location: location, Available: 100})
+		}
+		return
+	}
+
+	serverItems := func(cores, memory, storage int) []common.ProductItem {
+		return []common.ProductItem{
+			{ID: 1, Name: "Cores", Amount: cores},
+			{ID: 2, Name: "Memory", Amount: memory},
+			{ID: 3, Name: "Storage", Amount: storage},
+		}
+	}
+
+	f.products = []common.Product{
+		{ID: 1, Name: "b1.1x1", Type: serverType, Items: serverItems(1, 1, 20), Availability: availability(1)},
+		{ID: 2, Name: "b1.2x2", Type: serverType, Items: serverItems(2, 2, 40), Availability: availability(1)},
+		{ID: 3, Name: "b1.4x4", Type: serverType, Items: serverItems(4, 4, 40), Availability: availability(1)},
+		{ID: 10, Name: "Volume", Type: volumeType, Availability: availability(1)},
+		{ID: 11, Name: "Snapshot", Type: snapshotType, Availability: availability(1)},
+		{ID: 12, Name: "Elastic IP", Type: elasticIPType, Availability: availability(1)},
+		{ID: 13, Name: "Load Balancer", Type: loadBalancerType, Availability: availability(1)},
+		{ID: 40, Name: "Kubernetes Cluster", Type: kubernetesType, Availability: availability(1)},
+		{ID: 43, Name: "Control Plane", Type: kubernetesNodeType, Availability: availability(1)},
+		{ID: 44, Name: "Worker Small", Type: kubernetesNodeType, Availability: availability(1)},
+		{ID: 45, Name: "Worker Large", Type: kubernetesNodeType, Availability: availability(1)},
+		{ID: 50, Name: "Mac mini M1", Type: macBareMetalType, Availability: availability(2)},
+		{ID: 51, Name: "Mac Bare Metal Elastic IP", Type: macBareMetalElasticIPType, Availability: availability(2)},
+	}
+}
+
+func (f *fakeAPI) registerCommonRoutes() {
+	f.handle(http.MethodGet, "/v4/entities/locations", func(r fakeRequest) (interface{}, error) {
+		return f.locations, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/entities/locations/{}", func(r fakeRequest) (interface{}, error) {
+		return f.location(r.param(0))
+	})
+
+	f.handle(http.MethodGet, "/v4/entities/modules", func(r fakeRequest) (interface{}, error) {
+		return f.modules, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/products", func(r fakeRequest) (interface{}, error) {
+		return f.products, nil
+	})
+
+	f.handle(http.MethodGet, "/v4/products/{}", func(r fakeRequest) (interface{}, error) {
+		return f.product(r.param(0))
+	})
+
+	f.handle(http.MethodGet, "/v4/orders/{}", func(r fakeRequest) (interface{}, error) {
+		id := r.param(0)
+
+		if !f.heldOrders[f.orderNames[id]] {
+			f.advance("order", id)
+		}
+
+		order, ok := f.orders.Get(id)
+		if !ok {
+			return nil, fakeNotFound("order", id)
+		}
+
+		if f.pending("order", id) {
+			order.Status = common.OrderStatus{ID: common.OrderStatusProcessing, Name: "Processing"}
+			f.orders.Put(id, order)
+		}
+
+		return order, nil
+	})
+}
+
+func newFakeAPIClient(t *testing.T, token string) goclient.Client {
+	server := httptest.NewServer(newFakeAPI(fakeAPIToken))
+	t.Cleanup(server.Close)
+
+	return goclient.NewClient(goclient.WithToken(token), goclient.WithBase(server.URL+"/"))
+}
+
+func TestFakeAPI_Authentication(t *testing.T) {
+	client := newFakeAPIClient(t, "invalid")
+
+	_, err := common.NewLocationService(client).List(context.Background(), goclient.Cursor{NoFilter: 1})
+	if err == nil || !strings.Contains(err.Error(), "invalid authentication token") {
+		t.Errorf("expected authentication error, got %v", err)
+	}
+}
+
+func TestFakeAPI_UnknownRoute(t *testing.T) {
+	client := newFakeAPIClient(t, fakeAPIToken)
+
+	err := client.Get(context.Background(), "/v4/unknown", nil)
+	if err == nil || isNotFoundError(err) {
+		t.Errorf("expected error other than not found, got %v", err)
+	}
+}
+
+func TestFakeAPI_NotFound(t *testing.T) {
+	client := newFakeAPIClient(t, fakeAPIToken)
+
+	_, err := compute.NewServerService(client).Get(context.Background(), 42)
+	if !isNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestFakeAPI_Order(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeAPIClient(t, fakeAPIToken)
+	serverService := compute.NewServerService(client)
+
+	ordering, err := serverService.Create(ctx, compute.ServerCreate{
+		Name:       "test-server",
+		LocationID: 1,
+		ImageID:    1,
+		ProductID:  1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	order, diagnostics := waitForOrder(ctx, common.NewOrderService(client), ordering)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	server, err := serverService.Get(ctx, order.Product.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if server.Name != "test-server" || server.Status.ID != compute.ServerStatusRunning {
+		t.Errorf("unexpected server %q with status %d", server.Name, server.Status.ID)
+	}
+
+	if len(server.Networks) != 1 || server.Networks[0].Name != "default" || server.Networks[0].Interfaces[0].PrivateIP == "" {
+		t.Errorf("expected server to be attached to the default network, got %+v", server.Networks)
+	}
+}
+
+func TestFakeAPI_LoadBalancerMutable(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeAPIClient(t, fakeAPIToken)
+	loadBalancerService := compute.NewLoadBalancerService(client)
+
+	networks, err := compute.NewNetworkService(client).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ordering, err := loadBalancerService.Create(ctx, compute.LoadBalancerCreate{
+		Name:       "test-load-balancer",
+		LocationID: 1,
+		NetworkID:  networks.Items[0].ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	order, diagnostics := waitForOrder(ctx, common.NewOrderService(client), ordering)
+	diagnostics.Append(waitForLoadBalancerMutable(ctx, loadBalancerService, order.Product.ID)...)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	create := compute.LoadBalancerPoolCreate{
+		EntryProtocolID:      1,
+		TargetProtocolID:     1,
+		EntryPort:            80,
+		BalancingAlgorithmID: 1,
+		HealthCheck:          compute.LoadBalancerHealthCheckOptions{TypeID: 1},
+	}
+
+	pools := loadBalancerService.Pools(order.Product.ID)
+	if _, err := pools.Create(ctx, create); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := pools.Create(ctx, create); err == nil || !strings.Contains(err.Error(), "currently working") {
+		t.Errorf("expected load balancer to be immutable while working, got %v", err)
+	}
+
+	diagnostics = waitForLoadBalancerMutable(ctx, loadBalancerService, order.Product.ID)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	if _, err := pools.Create(ctx, create); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
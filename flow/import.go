@@ -0,0 +1,62 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const importIDSeparator = "/"
+
+// importStateFromID parses the import identifier of the request and stores its parts into the given attributes. The
+// identifier must consist of one numeric identifier per attribute, separated by slashes. For example, importing with
+// the attributes "load_balancer_id" and "id" expects an identifier in the format "<load_balancer_id>/<id>".
+func importStateFromID(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse, attributes ...string) {
+	ids, err := parseImportID(request.ID, len(attributes))
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("expected import id in the format %q: %s", importIDFormat(attributes), err),
+		)
+		return
+	}
+
+	for idx, attribute := range attributes {
+		diagnostics := response.State.SetAttribute(ctx, path.Root(attribute), types.Int64{Value: ids[idx]})
+		response.Diagnostics.Append(diagnostics...)
+	}
+}
+
+// parseImportID splits the import identifier into the expected number of positive numeric identifiers.
+func parseImportID(id string, count int) ([]int64, error) {
+	parts := strings.Split(id, importIDSeparator)
+	if len(parts) != count {
+		return nil, fmt.Errorf("got %d parts instead of %d in %q", len(parts), count, id)
+	}
+
+	ids := make([]int64, count)
+	for idx, part := range parts {
+		value, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || value <= 0 {
+			return nil, fmt.Errorf("part %d of %q is not a valid identifier", idx+1, id)
+		}
+
+		ids[idx] = value
+	}
+
+	return ids, nil
+}
+
+func importIDFormat(attributes []string) string {
+	parts := make([]string, len(attributes))
+	for idx, attribute := range attributes {
+		parts[idx] = "<" + attribute + ">"
+	}
+
+	return strings.Join(parts, importIDSeparator)
+}
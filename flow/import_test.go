@@ -0,0 +1,74 @@
+package flow
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		count    int
+		expected []int64
+		err      string
+	}{
+		{name: "single", id: "42", count: 1, expected: []int64{42}},
+		{name: "composite", id: "1/2/3", count: 3, expected: []int64{1, 2, 3}},
+		{name: "missing part", id: "1/2", count: 3, err: "got 2 parts instead of 3"},
+		{name: "too many parts", id: "1/2/3", count: 2, err: "got 3 parts instead of 2"},
+		{name: "empty part", id: "1//3", count: 3, err: "part 2"},
+		{name: "not a number", id: "1/abc", count: 2, err: "part 2"},
+		{name: "negative", id: "-1/2", count: 2, err: "part 1"},
+		{name: "empty", id: "", count: 1, err: "part 1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := parseImportID(test.id, test.count)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(ids, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, ids)
+			}
+		})
+	}
+}
+
+func TestImportIDFormat(t *testing.T) {
+	format := importIDFormat([]string{"load_balancer_id", "pool_id", "id"})
+	if expected := "<load_balancer_id>/<pool_id>/<id>"; format != expected {
+		t.Errorf("expected %q, got %q", expected, format)
+	}
+}
+
+// testAccImportStateID builds the composite import id of a resource from the given attributes of its state.
+func testAccImportStateID(name string, attributes ...string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+
+		parts := make([]string, len(attributes))
+		for idx, attribute := range attributes {
+			parts[idx] = res.Primary.Attributes[attribute]
+		}
+
+		return strings.Join(parts, importIDSeparator), nil
+	}
+}
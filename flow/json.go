@@ -0,0 +1,97 @@
+package flow
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// normalizeJSON re-encodes the JSON document in its canonical form, which is compact and has its object keys sorted.
+// This matches the output of the jsonencode function of terraform.
+func normalizeJSON(document []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
+
+// equivalentJSON reports whether both JSON documents encode the same value, regardless of their formatting.
+func equivalentJSON(a, b string) bool {
+	normalizedA, err := normalizeJSON([]byte(a))
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := normalizeJSON([]byte(b))
+	if err != nil {
+		return false
+	}
+
+	return normalizedA == normalizedB
+}
+
+// mergeJSONObjects replaces the top-level keys of the JSON object document with the ones of the patch. The remaining
+// keys of the document are retained.
+func mergeJSONObjects(document, patch string) (string, error) {
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(document), &merged); err != nil {
+		return "", err
+	}
+
+	var replacements map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(patch), &replacements); err != nil {
+		return "", err
+	}
+
+	if merged == nil {
+		merged = map[string]json.RawMessage{}
+	}
+
+	for key, value := range replacements {
+		merged[key] = value
+	}
+
+	encoded, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeJSON(encoded)
+}
+
+// selectJSONObject returns the top-level keys of the JSON object document which are also present in the selection. Keys
+// of the selection missing in the document are omitted.
+func selectJSONObject(document, selection string) (string, error) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(document), &values); err != nil {
+		return "", err
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(selection), &keys); err != nil {
+		return "", err
+	}
+
+	selected := map[string]json.RawMessage{}
+	for key := range keys {
+		if value, ok := values[key]; ok {
+			selected[key] = value
+		}
+	}
+
+	encoded, err := json.Marshal(selected)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeJSON(encoded)
+}
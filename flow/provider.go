@@ -2,20 +2,43 @@ package flow
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ tfsdk.Provider = (*provider)(nil)
 
+const (
+	defaultMaxRetries   = 4
+	defaultRetryMaxWait = 30 * time.Second
+
+	retryBaseWait = time.Second
+)
+
+var (
+	minPollInterval = time.Second
+	maxPollInterval = 10 * time.Second
+
+	// transitionGracePeriod bounds how long the beginning of an operation is waited on, as fast operations may begin
+	// and end between two polls.
+	transitionGracePeriod = time.Minute
+)
+
 type Option func(p *provider)
 
 func WithVersion(version string) Option {
@@ -52,8 +75,10 @@ type provider struct {
 }
 
 type providerData struct {
-	Token    types.String `tfsdk:"token"`
-	Endpoint types.String `tfsdk:"endpoint"`
+	Token        types.String `tfsdk:"token"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
 }
 
 func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -70,6 +95,16 @@ func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostic
 				MarkdownDescription: "endpoint for the flow api",
 				Optional:            true,
 			},
+			"max_retries": {
+				Type:                types.Int64Type,
+				MarkdownDescription: fmt.Sprintf("maximum number of retries of a request after a transient api failure (defaults to %d)", defaultMaxRetries),
+				Optional:            true,
+			},
+			"retry_max_wait": {
+				Type:                types.StringType,
+				MarkdownDescription: fmt.Sprintf("maximum wait duration between two retries of a request (defaults to %s)", defaultRetryMaxWait),
+				Optional:            true,
+			},
 		},
 	}, nil
 }
@@ -106,13 +141,55 @@ func (p *provider) Configure(ctx context.Context, request tfsdk.ConfigureProvide
 		}
 	}
 
+	retry := retryTransport{
+		maxRetries: defaultMaxRetries,
+		maxWait:    defaultRetryMaxWait,
+	}
+
+	if !data.MaxRetries.Null {
+		if data.MaxRetries.Value < 0 {
+			response.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"The maximum number of retries must not be negative.",
+			)
+			return
+		}
+
+		retry.maxRetries = int(data.MaxRetries.Value)
+	}
+
+	if !data.RetryMaxWait.Null {
+		maxWait, err := time.ParseDuration(data.RetryMaxWait.Value)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("unable to parse retry max wait duration: %s", err),
+			)
+			return
+		}
+
+		if maxWait < 0 {
+			response.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				"The maximum wait duration between two retries must not be negative.",
+			)
+			return
+		}
+
+		retry.maxWait = maxWait
+	}
+
 	p.client = goclient.NewClient(
 		goclient.WithToken(data.Token.Value),
 		goclient.WithBase(data.Endpoint.Value),
 		goclient.WithUserAgent(fmt.Sprintf("terraform-provider-flow/%s", p.version)),
 
 		goclient.WithHTTPClientOption(func(c *http.Client) {
-			c.Transport = logTransport{base: c.Transport}
+			retry.base = logTransport{base: c.Transport}
+			c.Transport = retry
 		}),
 	)
 
@@ -136,6 +213,7 @@ func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceT
 		"flow_compute_security_group":               computeSecurityGroupResourceType{},
 		"flow_compute_security_group_rule":          computeSecurityGroupRuleResourceType{},
 		"flow_compute_server":                       computeServerResourceType{},
+		"flow_compute_snapshot":                     computeSnapshotResourceType{},
 		"flow_compute_volume":                       computeVolumeResourceType{},
 		"flow_compute_volume_attachment":            computeVolumeAttachmentResourceType{},
 
@@ -152,37 +230,70 @@ func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceT
 
 func (p *provider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
-		"flow_location": locationDataSourceType{},
-		"flow_module":   moduleDataSourceType{},
-		"flow_product":  productDataSourceType{},
-
-		"flow_compute_certificate":                     computeCertificateDataSourceType{},
-		"flow_compute_elastic_ip":                      computeElasticIPDataSourceType{},
-		"flow_compute_image":                           computeImageDataSourceType{},
-		"flow_compute_key_pair":                        computeKeyPairDataSourceType{},
-		"flow_compute_load_balancer_algorithm":         computeLoadBalancerAlgorithmDataSourceType{},
-		"flow_compute_load_balancer_health_check_type": computeLoadBalancerHealthCheckTypeDataSourceType{},
-		"flow_compute_load_balancer_member":            computeLoadBalancerMemberDataSourceType{},
-		"flow_compute_load_balancer_pool":              computeLoadBalancerPoolDataSourceType{},
-		"flow_compute_load_balancer_protocol":          computeLoadBalancerProtocolDataSourceType{},
-		"flow_compute_network":                         computeNetworkDataSourceType{},
-		"flow_compute_network_interface":               computeNetworkInterfaceDataSourceType{},
-		"flow_compute_router":                          computeRouterDataSourceType{},
-		"flow_compute_router_interface":                computeRouterInterfaceDataSourceType{},
-		"flow_compute_router_route":                    computeRouterRouteDataSourceType{},
-		"flow_compute_security_group":                  computeSecurityGroupDataSourceType{},
-		"flow_compute_security_group_rule":             computeSecurityGroupRuleDataSourceType{},
-		"flow_compute_server":                          computeServerDataSourceType{},
-		"flow_compute_snapshot":                        computeSnapshotDataSourceType{},
-		"flow_compute_volume":                          computeVolumeDataSourceType{},
-
-		"flow_kubernetes_cluster":     kubernetesClusterDataSourceType{},
-		"flow_kubernetes_kube_config": kubernetesKubeConfigDataSourceType{},
-
-		"flow_mac_bare_metal_elastic_ip":          macBareMetalElasticIPDataSourceType{},
-		"flow_mac_bare_metal_network":             macBareMetalNetworkDataSourceType{},
-		"flow_mac_bare_metal_security_group":      macBareMetalSecurityGroupDataSourceType{},
-		"flow_mac_bare_metal_security_group_rule": macBareMetalSecurityGroupRuleDataSourceType{},
+		"flow_location":  locationDataSourceType{},
+		"flow_locations": locationsDataSourceType{},
+		"flow_module":    moduleDataSourceType{},
+		"flow_modules":   modulesDataSourceType{},
+		"flow_product":   productDataSourceType{},
+		"flow_products":  productsDataSourceType{},
+
+		"flow_compute_certificate":                      computeCertificateDataSourceType{},
+		"flow_compute_certificates":                     computeCertificatesDataSourceType{},
+		"flow_compute_elastic_ip":                       computeElasticIPDataSourceType{},
+		"flow_compute_elastic_ips":                      computeElasticIPsDataSourceType{},
+		"flow_compute_image":                            computeImageDataSourceType{},
+		"flow_compute_images":                           computeImagesDataSourceType{},
+		"flow_compute_key_pair":                         computeKeyPairDataSourceType{},
+		"flow_compute_key_pairs":                        computeKeyPairsDataSourceType{},
+		"flow_compute_load_balancer_algorithm":          computeLoadBalancerAlgorithmDataSourceType{},
+		"flow_compute_load_balancer_algorithms":         computeLoadBalancerAlgorithmsDataSourceType{},
+		"flow_compute_load_balancer_health_check_type":  computeLoadBalancerHealthCheckTypeDataSourceType{},
+		"flow_compute_load_balancer_health_check_types": computeLoadBalancerHealthCheckTypesDataSourceType{},
+		"flow_compute_load_balancer_member":             computeLoadBalancerMemberDataSourceType{},
+		"flow_compute_load_balancer_members":            computeLoadBalancerMembersDataSourceType{},
+		"flow_compute_load_balancer_pool":               computeLoadBalancerPoolDataSourceType{},
+		"flow_compute_load_balancer_pools":              computeLoadBalancerPoolsDataSourceType{},
+		"flow_compute_load_balancer_protocol":           computeLoadBalancerProtocolDataSourceType{},
+		"flow_compute_load_balancer_protocols":          computeLoadBalancerProtocolsDataSourceType{},
+		"flow_compute_network":                          computeNetworkDataSourceType{},
+		"flow_compute_networks":                         computeNetworksDataSourceType{},
+		"flow_compute_network_interface":                computeNetworkInterfaceDataSourceType{},
+		"flow_compute_network_interfaces":               computeNetworkInterfacesDataSourceType{},
+		"flow_compute_router":                           computeRouterDataSourceType{},
+		"flow_compute_routers":                          computeRoutersDataSourceType{},
+		"flow_compute_router_interface":                 computeRouterInterfaceDataSourceType{},
+		"flow_compute_router_interfaces":                computeRouterInterfacesDataSourceType{},
+		"flow_compute_router_route":                     computeRouterRouteDataSourceType{},
+		"flow_compute_router_routes":                    computeRouterRoutesDataSourceType{},
+		"flow_compute_security_group":                   computeSecurityGroupDataSourceType{},
+		"flow_compute_security_groups":                  computeSecurityGroupsDataSourceType{},
+		"flow_compute_security_group_rule":              computeSecurityGroupRuleDataSourceType{},
+		"flow_compute_security_group_rules":             computeSecurityGroupRulesDataSourceType{},
+		"flow_compute_server":                           computeServerDataSourceType{},
+		"flow_compute_servers":                          computeServersDataSourceType{},
+		"flow_compute_snapshot":                         computeSnapshotDataSourceType{},
+		"flow_compute_snapshots":                        computeSnapshotsDataSourceType{},
+		"flow_compute_volume":                           computeVolumeDataSourceType{},
+		"flow_compute_volumes":                          computeVolumesDataSourceType{},
+
+		"flow_kubernetes_cluster":       kubernetesClusterDataSourceType{},
+		"flow_kubernetes_clusters":      kubernetesClustersDataSourceType{},
+		"flow_kubernetes_cluster_node":  kubernetesClusterNodeDataSourceType{},
+		"flow_kubernetes_cluster_nodes": kubernetesClusterNodesDataSourceType{},
+		"flow_kubernetes_kube_config":   kubernetesKubeConfigDataSourceType{},
+		"flow_kubernetes_version":       kubernetesVersionDataSourceType{},
+
+		"flow_mac_bare_metal_device":               macBareMetalDeviceDataSourceType{},
+		"flow_mac_bare_metal_device_vnc":           macBareMetalDeviceVNCDataSourceType{},
+		"flow_mac_bare_metal_devices":              macBareMetalDevicesDataSourceType{},
+		"flow_mac_bare_metal_elastic_ip":           macBareMetalElasticIPDataSourceType{},
+		"flow_mac_bare_metal_elastic_ips":          macBareMetalElasticIPsDataSourceType{},
+		"flow_mac_bare_metal_network":              macBareMetalNetworkDataSourceType{},
+		"flow_mac_bare_metal_networks":             macBareMetalNetworksDataSourceType{},
+		"flow_mac_bare_metal_security_group":       macBareMetalSecurityGroupDataSourceType{},
+		"flow_mac_bare_metal_security_groups":      macBareMetalSecurityGroupsDataSourceType{},
+		"flow_mac_bare_metal_security_group_rule":  macBareMetalSecurityGroupRuleDataSourceType{},
+		"flow_mac_bare_metal_security_group_rules": macBareMetalSecurityGroupRulesDataSourceType{},
 	}, nil
 }
 
@@ -200,31 +311,178 @@ func convertToLocalProviderType(p tfsdk.Provider) (prov *provider, diagnostics d
 	return
 }
 
-func waitForCondition(ctx context.Context, check func(ctx context.Context) (bool, diag.Diagnostics)) (diagnostics diag.Diagnostics) {
+// waitForCondition polls check until it reports completion or returns an error. The subject describes what is being
+// waited on and is included in the diagnostics if the context expires beforehand.
+func waitForCondition(ctx context.Context, subject string, check func(ctx context.Context) (bool, diag.Diagnostics)) (diagnostics diag.Diagnostics) {
 	done, d := check(ctx)
+	if !done && ctx.Err() != nil {
+		// the context expired during the check, whose errors only repeat the context error without the subject
+		return waitInterrupted(ctx, subject)
+	}
+
 	diagnostics.Append(d...)
 	if done || diagnostics.HasError() {
 		return
 	}
 
-	ticker := time.NewTicker(time.Second)
-	defer ticker.Stop()
+	interval := minPollInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-timer.C:
 
 		case <-ctx.Done():
-			diagnostics.AddError("Timeout", "Timeout while waiting for condition")
-			return
+			return waitInterrupted(ctx, subject)
 		}
 
 		done, d = check(ctx)
+		if !done && ctx.Err() != nil {
+			return waitInterrupted(ctx, subject)
+		}
+
 		diagnostics.Append(d...)
 		if done || diagnostics.HasError() {
 			return
 		}
+
+		// long-running operations are polled less frequently the longer they take
+		interval *= 2
+		if interval > maxPollInterval {
+			interval = maxPollInterval
+		}
+
+		timer.Reset(interval)
+	}
+}
+
+// waitForTransition waits until check reports that an operation has begun. As the operation may begin and end
+// between two polls, the wait also ends without an error once the grace period has passed.
+func waitForTransition(ctx context.Context, subject string, grace time.Duration, check func(ctx context.Context) (bool, diag.Diagnostics)) diag.Diagnostics {
+	graceCtx, cancel := context.WithTimeout(ctx, grace)
+	defer cancel()
+
+	diagnostics := waitForCondition(graceCtx, subject, check)
+	if diagnostics.HasError() && ctx.Err() == nil && errors.Is(graceCtx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+
+	return diagnostics
+}
+
+// waitInterrupted returns the diagnostics of a wait for the subject which has been interrupted by the context.
+func waitInterrupted(ctx context.Context, subject string) (diagnostics diag.Diagnostics) {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		diagnostics.AddError("Timeout", fmt.Sprintf("timeout while waiting for %s", subject))
+	} else {
+		diagnostics.AddError("Canceled", fmt.Sprintf("canceled while waiting for %s", subject))
+	}
+
+	return
+}
+
+// waitForOrder waits until the given order has been processed by the platform.
+func waitForOrder(ctx context.Context, orderService common.OrderService, ordering common.Ordering) (order common.Order, diagnostics diag.Diagnostics) {
+	orderID, err := ordering.ExtractIdentifier()
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to extract order identifier: %s", err))
+		return
+	}
+
+	diagnostics = waitForCondition(ctx, fmt.Sprintf("order %d to be processed", orderID), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		order, err = orderService.Get(ctx, orderID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get order %d: %s", orderID, err))
+			return
+		}
+
+		if order.Status.ID == common.OrderStatusFailed {
+			diagnostics.AddError("Order Failed", fmt.Sprintf("order %d could not be processed", orderID))
+			return
+		}
+
+		done = order.Status.ID == common.OrderStatusSucceeded
+		return
+	})
+
+	return
+}
+
+// setOrderedState stores the planned state of a resource together with the identifier of its order, before waiting for
+// the order to be processed. If waiting fails or is interrupted, the resource is kept as tainted instead of being lost,
+// and its identifier is recovered from the order afterwards. Values only known after the creation are stored as null.
+func setOrderedState(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State, ordering common.Ordering) (diagnostics diag.Diagnostics) {
+	orderID, err := ordering.ExtractIdentifier()
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to extract order identifier: %s", err))
+		return
+	}
+
+	raw, err := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.IsKnown() {
+			return tftypes.NewValue(value.Type(), nil), nil
+		}
+
+		return value, nil
+	})
+	if err != nil {
+		diagnostics.AddError("Internal Error", fmt.Sprintf("unable to convert planned state: %s", err))
+		return
 	}
+
+	state.Raw = raw
+	return state.SetAttribute(ctx, path.Root("order_id"), types.Int64{Value: int64(orderID)})
+}
+
+// getOrderedID returns the identifier of the entity created by the given order and whether the order has been
+// processed. The identifier is null while the order is being processed or if it failed.
+func getOrderedID(ctx context.Context, orderService common.OrderService, orderID types.Int64) (id types.Int64, processed bool, diagnostics diag.Diagnostics) {
+	id = types.Int64{Null: true}
+
+	order, err := orderService.Get(ctx, int(orderID.Value))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to get order %d: %s", orderID.Value, err))
+		return
+	}
+
+	switch order.Status.ID {
+	case common.OrderStatusSucceeded:
+		return types.Int64{Value: int64(order.Product.ID)}, true, nil
+	case common.OrderStatusFailed:
+		return id, true, nil
+	}
+
+	return
+}
+
+// waitForOrderedID waits until the given order has been processed and returns the identifier of the created entity. The
+// identifier is null if the order failed and the entity was therefore never created.
+func waitForOrderedID(ctx context.Context, orderService common.OrderService, orderID types.Int64) (id types.Int64, diagnostics diag.Diagnostics) {
+	diagnostics = waitForCondition(ctx, fmt.Sprintf("order %d to be processed", orderID.Value), func(ctx context.Context) (processed bool, diagnostics diag.Diagnostics) {
+		id, processed, diagnostics = getOrderedID(ctx, orderService, orderID)
+		return
+	})
+
+	return
+}
+
+// waitForDeletion waits until the subject has been deleted. Many entities are torn down asynchronously by the platform
+// and remain visible for a while after their deletion has been accepted. The get function must return the error of
+// fetching the subject, which reports not found as soon as the deletion has completed.
+func waitForDeletion(ctx context.Context, subject string, get func(ctx context.Context) error) diag.Diagnostics {
+	return waitForCondition(ctx, fmt.Sprintf("%s to be deleted", subject), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		err := get(ctx)
+		if isNotFoundError(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get %s: %s", subject, err))
+		}
+
+		return
+	})
 }
 
 type logTransport struct {
@@ -259,3 +517,181 @@ func (l logTransport) transport() http.RoundTripper {
 
 	return l.base
 }
+
+// retryTransport retries requests which failed because of a transient error of the api or the network. Rate limited
+// requests are rejected by the api before being processed and are therefore retried regardless of their method. All
+// other failures are only retried for idempotent requests.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func (r retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := r.transport().RoundTrip(req)
+		if attempt >= r.maxRetries || !isRetryableRequest(req, res, err) {
+			return res, err
+		}
+
+		next, rewindErr := rewindRequest(req)
+		if rewindErr != nil {
+			return res, err
+		}
+
+		wait := r.backoff(attempt, res)
+
+		additionalContext := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+
+		if err == nil {
+			// drain the body to allow the connection to be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+
+			tflog.Debug(req.Context(), fmt.Sprintf("retrying request to `%s %s` after `%s`", req.Method, req.URL.String(), res.Status), additionalContext)
+		} else {
+			tflog.Debug(req.Context(), fmt.Sprintf("retrying request to `%s %s` after `%s`", req.Method, req.URL.String(), err), additionalContext)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+
+		req = next
+	}
+}
+
+func (r retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if wait > r.maxWait {
+				return r.maxWait
+			}
+
+			return wait
+		}
+	}
+
+	wait := retryBaseWait << attempt
+	if wait <= 0 || wait > r.maxWait {
+		wait = r.maxWait
+	}
+
+	// add jitter to prevent multiple clients from retrying in lockstep
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+func (r retryTransport) transport() http.RoundTripper {
+	if r.base == nil {
+		return http.DefaultTransport
+	}
+
+	return r.base
+}
+
+func isRetryableRequest(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		// the context is controlled by terraform and indicates that the operation should be aborted
+		if req.Context().Err() != nil {
+			return false
+		}
+
+		return isIdempotentMethod(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotentMethod(req.Method)
+	}
+
+	return false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("request body cannot be rewound")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	next.Body = body
+	return next, nil
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// deleteOnceReleased deletes the subject as soon as it is no longer in use by other entities. Deletions conflicting
+// with the current state of the platform are retried until the context expires, while any other error is reported
+// immediately. This allows deleting entities, such as networks, whose dependents are still being torn down.
+func deleteOnceReleased(ctx context.Context, subject string, del func(ctx context.Context) error) diag.Diagnostics {
+	var conflict error
+	diagnostics := waitForCondition(ctx, fmt.Sprintf("%s to be released", subject), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		err := del(ctx)
+		if err == nil || isNotFoundError(err) {
+			return true, nil
+		}
+
+		if isConflictError(err) {
+			conflict = err
+			return
+		}
+
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete %s: %s", subject, err))
+		return
+	})
+
+	if diagnostics.HasError() && ctx.Err() != nil && conflict != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete %s: %s", subject, conflict))
+	}
+
+	return diagnostics
+}
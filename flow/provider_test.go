@@ -1,12 +1,449 @@
 package flow
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"flow": providerserver.NewProtocol6WithError(New(
-		WithVersion("test"),
-	)),
+var (
+	protoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
+	// testAccEndpoint is the endpoint of the api the acceptance tests are run against.
+	testAccEndpoint = "https://api.flow.swiss/"
+
+	// testAccFakeAPI is the fake the acceptance tests are run against, or nil if they are run against the real api.
+	testAccFakeAPI *fakeAPI
+)
+
+// TestMain runs the acceptance tests against an in-memory fake of the flow api unless FLOW_ACC_LIVE is set, in which
+// case the real api is used with the credentials from the environment.
+func TestMain(m *testing.M) {
+	options := []Option{WithVersion("test")}
+
+	var server *httptest.Server
+	if _, live := os.LookupEnv("FLOW_ACC_LIVE"); !live {
+		testAccFakeAPI = newFakeAPI(fakeAPIToken)
+		server = httptest.NewServer(testAccFakeAPI)
+
+		_ = os.Setenv("FLOW_TOKEN", fakeAPIToken)
+		_ = os.Unsetenv("FLOW_ENDPOINT")
+
+		minPollInterval = 10 * time.Millisecond
+		maxPollInterval = 50 * time.Millisecond
+
+		testAccEndpoint = server.URL + "/"
+		options = append(options, WithDefaultEndpoint(testAccEndpoint))
+	} else if endpoint, ok := os.LookupEnv("FLOW_ENDPOINT"); ok {
+		testAccEndpoint = endpoint
+	}
+
+	protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"flow": providerserver.NewProtocol6WithError(New(options...)),
+	}
+
+	code := m.Run()
+
+	if server != nil {
+		server.Close()
+	}
+
+	os.Exit(code)
+}
+
+// newTestAccClient returns a client for the api the acceptance tests are run against. It allows the tests to modify
+// entities outside of terraform.
+func newTestAccClient() goclient.Client {
+	return goclient.NewClient(goclient.WithToken(os.Getenv("FLOW_TOKEN")), goclient.WithBase(testAccEndpoint))
+}
+
+// testAccCheckResourceDisappears deletes the entity of the resource outside of terraform, so the following plan is
+// expected to recreate it.
+func testAccCheckResourceDisappears(name string, remove func(ctx context.Context, client goclient.Client, id int) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("invalid id of resource %s: %w", name, err)
+		}
+
+		return remove(context.Background(), newTestAccClient(), id)
+	}
+}
+
+func TestProvider_ConfigureRetries(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		maxRetries   interface{}
+		retryMaxWait interface{}
+		expected     string
+	}{
+		{name: "defaults"},
+		{name: "valid", maxRetries: 3, retryMaxWait: "5s"},
+		{name: "negative max retries", maxRetries: -1, expected: "Invalid Max Retries"},
+		{name: "invalid retry max wait", retryMaxWait: "soon", expected: "Invalid Retry Max Wait"},
+		{name: "negative retry max wait", retryMaxWait: "-5s", expected: "Invalid Retry Max Wait"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := New(WithVersion("test"))
+
+			schema, diagnostics := p.GetSchema(ctx)
+			if diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diagnostics)
+			}
+
+			config := tftypes.NewValue(schema.TerraformType(ctx), map[string]tftypes.Value{
+				"token":          tftypes.NewValue(tftypes.String, "token"),
+				"endpoint":       tftypes.NewValue(tftypes.String, nil),
+				"max_retries":    tftypes.NewValue(tftypes.Number, test.maxRetries),
+				"retry_max_wait": tftypes.NewValue(tftypes.String, test.retryMaxWait),
+			})
+
+			var response tfsdk.ConfigureProviderResponse
+			p.Configure(ctx, tfsdk.ConfigureProviderRequest{Config: tfsdk.Config{Raw: config, Schema: schema}}, &response)
+
+			if test.expected == "" {
+				if response.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", response.Diagnostics)
+				}
+				return
+			}
+
+			if !response.Diagnostics.HasError() || response.Diagnostics[0].Summary() != test.expected {
+				t.Errorf("expected %q diagnostic, got %v", test.expected, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RetriesTransientFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: retryTransport{maxRetries: 4, maxWait: time.Millisecond}}
+
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, res.StatusCode)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransport_StopsAfterMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: retryTransport{maxRetries: 2, maxWait: time.Millisecond}}
+
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status %d, got %d", http.StatusBadGateway, res.StatusCode)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransport_NonIdempotentRequests(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		expectedCalls int32
+	}{
+		{name: "service unavailable", status: http.StatusServiceUnavailable, expectedCalls: 1},
+		{name: "too many requests", status: http.StatusTooManyRequests, expectedCalls: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "payload" {
+					t.Errorf("expected request body to be replayed, got %q", body)
+				}
+
+				if atomic.AddInt32(&calls, 1) == 1 {
+					w.WriteHeader(test.status)
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: retryTransport{maxRetries: 4, maxWait: time.Millisecond}}
+
+			res, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer res.Body.Close()
+
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := retryTransport{maxWait: 10 * time.Second}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if wait := transport.backoff(0, res); wait != 3*time.Second {
+		t.Errorf("expected retry after header to be honored, got %s", wait)
+	}
+
+	res = &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := transport.backoff(0, res); wait != 10*time.Second {
+		t.Errorf("expected retry after header to be capped, got %s", wait)
+	}
+
+	for attempt := 0; attempt < 8; attempt++ {
+		expected := retryBaseWait << attempt
+		if expected > transport.maxWait {
+			expected = transport.maxWait
+		}
+
+		wait := transport.backoff(attempt, nil)
+		if wait < expected/2 || wait > expected {
+			t.Errorf("expected backoff of attempt %d to be between %s and %s, got %s", attempt, expected/2, expected, wait)
+		}
+	}
+}
+
+func TestWaitForCondition_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), minPollInterval/2)
+	defer cancel()
+
+	var checks int
+	diagnostics := waitForCondition(ctx, "server 42 to be running", func(ctx context.Context) (bool, diag.Diagnostics) {
+		checks++
+		return false, nil
+	})
+
+	if !diagnostics.HasError() {
+		t.Fatal("expected timeout diagnostic")
+	}
+
+	if detail := diagnostics[0].Detail(); !strings.Contains(detail, "server 42 to be running") {
+		t.Errorf("expected diagnostic to name the subject, got %q", detail)
+	}
+
+	if checks != 1 {
+		t.Errorf("expected 1 check, got %d", checks)
+	}
+}
+
+// TestWaitForCondition_TimeoutDuringCheck makes sure that a deadline expiring while checking names the subject instead
+// of reporting the bare context error of the check.
+func TestWaitForCondition_TimeoutDuringCheck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), minPollInterval/2)
+	defer cancel()
+
+	diagnostics := waitForCondition(ctx, "server 42 to be running", func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		<-ctx.Done()
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to get server: %s", ctx.Err()))
+		return
+	})
+
+	if len(diagnostics) != 1 || diagnostics[0].Summary() != "Timeout" {
+		t.Fatalf("expected a single timeout diagnostic, got %v", diagnostics)
+	}
+
+	if detail := diagnostics[0].Detail(); !strings.Contains(detail, "server 42 to be running") {
+		t.Errorf("expected diagnostic to name the subject, got %q", detail)
+	}
+}
+
+func TestWaitForTransition(t *testing.T) {
+	// a transition which is never observed ends the wait once the grace period has passed
+	diagnostics := waitForTransition(context.Background(), "server 42 to begin the reboot", minPollInterval, func(ctx context.Context) (bool, diag.Diagnostics) {
+		return false, nil
+	})
+	if diagnostics.HasError() {
+		t.Errorf("expected the grace period to end the wait, got %v", diagnostics)
+	}
+
+	// the deadline of the operation still applies within the grace period
+	ctx, cancel := context.WithTimeout(context.Background(), minPollInterval/2)
+	defer cancel()
+
+	diagnostics = waitForTransition(ctx, "server 42 to begin the reboot", time.Hour, func(ctx context.Context) (bool, diag.Diagnostics) {
+		return false, nil
+	})
+	if !diagnostics.HasError() {
+		t.Error("expected timeout diagnostic")
+	}
+
+	// errors of the check are not hidden by the grace period
+	diagnostics = waitForTransition(context.Background(), "server 42 to begin the reboot", time.Hour, func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		diagnostics.AddError("Server Error", "server 42 failed to reboot")
+		return
+	})
+	if !diagnostics.HasError() {
+		t.Error("expected the error of the check")
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	client := newFakeAPIClient(t, fakeAPIToken)
+	ctx := context.Background()
+
+	var checks int
+	diagnostics := waitForDeletion(ctx, "server 42", func(ctx context.Context) error {
+		checks++
+		if checks < 3 {
+			return nil
+		}
+
+		_, err := compute.NewServerService(client).Get(ctx, 42)
+		return err
+	})
+
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	if checks != 3 {
+		t.Errorf("expected 3 checks, got %d", checks)
+	}
+}
+
+func TestWaitForDeletion_Error(t *testing.T) {
+	diagnostics := waitForDeletion(context.Background(), "server 42", func(ctx context.Context) error {
+		return errors.New("connection refused")
+	})
+
+	if !diagnostics.HasError() {
+		t.Fatal("expected error diagnostic")
+	}
+
+	if detail := diagnostics[0].Detail(); !strings.Contains(detail, "unable to get server 42: connection refused") {
+		t.Errorf("expected diagnostic to contain the error, got %q", detail)
+	}
+}
+
+func TestDeleteOnceReleased(t *testing.T) {
+	client := newFakeAPIClient(t, fakeAPIToken)
+	ctx := context.Background()
+
+	networkService := compute.NewNetworkService(client)
+	network, err := networkService.Create(ctx, compute.NetworkCreate{Name: "test-network", LocationID: 1, CIDR: "10.0.0.0/24"})
+	if err != nil {
+		t.Fatalf("unable to create network: %s", err)
+	}
+
+	router, err := compute.NewRouterService(client).Create(ctx, compute.RouterCreate{Name: "test-router", LocationID: 1})
+	if err != nil {
+		t.Fatalf("unable to create router: %s", err)
+	}
+
+	routerInterfaceService := compute.NewRouterInterfaceService(client, router.ID)
+	routerInterface, err := routerInterfaceService.Create(ctx, compute.RouterInterfaceCreate{NetworkID: network.ID})
+	if err != nil {
+		t.Fatalf("unable to create router interface: %s", err)
+	}
+
+	var attempts int
+	diagnostics := deleteOnceReleased(ctx, "network", func(ctx context.Context) error {
+		attempts++
+		err := networkService.Delete(ctx, network.ID)
+		if !isConflictError(err) {
+			return err
+		}
+
+		// release the network, so that the next attempt succeeds
+		if err := routerInterfaceService.Delete(ctx, routerInterface.ID); err != nil {
+			t.Fatalf("unable to delete router interface: %s", err)
+		}
+
+		return err
+	})
+
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	if _, err := networkService.Get(ctx, network.ID); !isNotFoundError(err) {
+		t.Errorf("expected network to be deleted, got %v", err)
+	}
+}
+
+func TestDeleteOnceReleased_Error(t *testing.T) {
+	var attempts int
+	diagnostics := deleteOnceReleased(context.Background(), "network 42", func(ctx context.Context) error {
+		attempts++
+		return errors.New("connection refused")
+	})
+
+	if !diagnostics.HasError() {
+		t.Fatal("expected error diagnostic")
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected errors other than conflicts not to be retried, got %d attempts", attempts)
+	}
+
+	if detail := diagnostics[0].Detail(); !strings.Contains(detail, "unable to delete network 42: connection refused") {
+		t.Errorf("expected diagnostic to contain the error, got %q", detail)
+	}
 }
@@ -0,0 +1,172 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+	"github.com/flowswiss/terraform-provider-flow/validators"
+)
+
+// attributeReference allows configuring an identifier attribute, such as location_id, through a human-readable
+// reference attribute, such as location. The reference is resolved into the identifier at plan time.
+type attributeReference struct {
+	id              string
+	reference       string
+	requiresReplace bool
+	resolve         func(ctx context.Context, client goclient.Client, reference string) (int, error)
+}
+
+func locationReference(id string, reference string) attributeReference {
+	return attributeReference{
+		id:              id,
+		reference:       reference,
+		requiresReplace: true,
+		resolve: func(ctx context.Context, client goclient.Client, key string) (int, error) {
+			list, err := common.NewLocationService(client).List(ctx, goclient.Cursor{NoFilter: 1})
+			if err != nil {
+				return 0, fmt.Errorf("unable to list locations: %w", err)
+			}
+
+			location, err := filter.FindOne(locationDataSourceData{
+				ID:   types.Int64{Null: true},
+				Name: types.String{Null: true},
+				Key:  types.String{Value: key},
+			}, list.Items)
+			if err != nil {
+				return 0, fmt.Errorf("unable to find location with key %q: %w", key, err)
+			}
+
+			return location.ID, nil
+		},
+	}
+}
+
+func productReference(id string, reference string, productType string, requiresReplace bool) attributeReference {
+	return attributeReference{
+		id:              id,
+		reference:       reference,
+		requiresReplace: requiresReplace,
+		resolve: func(ctx context.Context, client goclient.Client, name string) (int, error) {
+			list, err := common.NewProductService(client).List(ctx, goclient.Cursor{NoFilter: 1})
+			if err != nil {
+				return 0, fmt.Errorf("unable to list products: %w", err)
+			}
+
+			product, err := filter.FindOne(productDataSourceData{
+				ID:   types.Int64{Null: true},
+				Name: types.String{Value: name},
+				Type: types.String{Value: productType},
+			}, list.Items)
+			if err != nil {
+				return 0, fmt.Errorf("unable to find product with name %q: %w", name, err)
+			}
+
+			return product.ID, nil
+		},
+	}
+}
+
+func computeImageReference(id string, reference string) attributeReference {
+	return attributeReference{
+		id:              id,
+		reference:       reference,
+		requiresReplace: true,
+		resolve: func(ctx context.Context, client goclient.Client, key string) (int, error) {
+			list, err := compute.NewImageService(client).List(ctx, goclient.Cursor{NoFilter: 1})
+			if err != nil {
+				return 0, fmt.Errorf("unable to list images: %w", err)
+			}
+
+			image, err := filter.FindOne(computeImageDataSourceData{
+				ID:              types.Int64{Null: true},
+				OperatingSystem: types.String{Null: true},
+				Version:         types.String{Null: true},
+				Key:             types.String{Value: key},
+				Category:        types.String{Null: true},
+				Type:            types.String{Null: true},
+			}, list.Items)
+			if err != nil {
+				return 0, fmt.Errorf("unable to find image with key %q: %w", key, err)
+			}
+
+			return image.ID, nil
+		},
+	}
+}
+
+// ConfigValidators returns the validators ensuring that exactly one of the identifier and the reference is configured.
+func (a attributeReference) ConfigValidators() []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		validators.MutuallyExclusive(a.id, a.reference),
+		validators.AtLeastOneOf(a.id, a.reference),
+	}
+}
+
+// ModifyPlan resolves the configured reference into the identifier of the planned state. As the identifier is not
+// configured in this case, a changed identifier has to be marked as requiring replacement explicitly.
+func (a attributeReference) ModifyPlan(ctx context.Context, client goclient.Client, request tfsdk.ModifyResourcePlanRequest, response *tfsdk.ModifyResourcePlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var reference types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(a.reference), &reference)...)
+	if response.Diagnostics.HasError() || reference.Null {
+		return
+	}
+
+	planned := types.Int64{Unknown: true}
+	if !reference.Unknown {
+		id, err := a.resolve(ctx, client, reference.Value)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(a.reference), "Invalid Reference", err.Error())
+			return
+		}
+
+		planned = types.Int64{Value: int64(id)}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(a.id), planned)...)
+	if response.Diagnostics.HasError() || !a.requiresReplace || request.State.Raw.IsNull() {
+		return
+	}
+
+	var current types.Int64
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(a.id), &current)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !planned.Equal(current) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(a.id))
+	}
+}
+
+// attributeReferences combines the validation and plan modification of multiple references of a resource.
+type attributeReferences []attributeReference
+
+func (a attributeReferences) ConfigValidators() []tfsdk.ResourceConfigValidator {
+	var result []tfsdk.ResourceConfigValidator
+	for _, reference := range a {
+		result = append(result, reference.ConfigValidators()...)
+	}
+
+	return result
+}
+
+func (a attributeReferences) ModifyPlan(ctx context.Context, client goclient.Client, request tfsdk.ModifyResourcePlanRequest, response *tfsdk.ModifyResourcePlanResponse) {
+	for _, reference := range a {
+		reference.ModifyPlan(ctx, client, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -261,7 +260,7 @@ func (c computeCertificateResource) Read(ctx context.Context, request tfsdk.Read
 		}
 	}
 
-	response.Diagnostics.AddError("Not Found", fmt.Sprintf("certificate with id %d not found", state.ID.Value))
+	response.State.RemoveResource(ctx)
 }
 
 func (c computeCertificateResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
@@ -284,5 +283,5 @@ func (c computeCertificateResource) Delete(ctx context.Context, request tfsdk.De
 }
 
 func (c computeCertificateResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
@@ -7,10 +7,11 @@ import (
 	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
 )
 
 var (
@@ -114,9 +115,14 @@ func (c computeElasticIPResource) Read(ctx context.Context, request tfsdk.ReadRe
 		return
 	}
 
-	elasticIP, diagnostics := findComputeElasticIP(ctx, c.elasticIPService, int(state.ID.Value))
-	response.Diagnostics.Append(diagnostics...)
-	if response.Diagnostics.HasError() {
+	elasticIP, err := findComputeElasticIP(ctx, c.elasticIPService, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to find elastic ip: %s", err))
 		return
 	}
 
@@ -150,22 +156,20 @@ func (c computeElasticIPResource) Delete(ctx context.Context, request tfsdk.Dele
 }
 
 func (c computeElasticIPResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
 
-func findComputeElasticIP(ctx context.Context, service compute.ElasticIPService, id int) (elasticIP compute.ElasticIP, diagnostics diag.Diagnostics) {
+func findComputeElasticIP(ctx context.Context, service compute.ElasticIPService, id int) (compute.ElasticIP, error) {
 	list, err := service.List(ctx, goclient.Cursor{NoFilter: 1})
 	if err != nil {
-		diagnostics.AddError("Client Error", fmt.Sprintf("unable to list elastic ips: %s", err))
-		return
+		return compute.ElasticIP{}, fmt.Errorf("list elastic ips: %w", err)
 	}
 
-	for _, elasticIP = range list.Items {
+	for _, elasticIP := range list.Items {
 		if elasticIP.ID == id {
-			return
+			return elasticIP, nil
 		}
 	}
 
-	diagnostics.AddError("Not Found", fmt.Sprintf("unable to find elastic ip with id %d", id))
-	return
+	return compute.ElasticIP{}, fmt.Errorf("elastic ip with id %d: %w", id, filter.ErrNoResults)
 }
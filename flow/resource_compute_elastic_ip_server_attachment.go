@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*computeElasticIPServerAttachmentResourceType)(nil)
-	_ tfsdk.Resource     = (*computeElasticIPServerAttachmentResource)(nil)
+	_ tfsdk.ResourceType            = (*computeElasticIPServerAttachmentResourceType)(nil)
+	_ tfsdk.Resource                = (*computeElasticIPServerAttachmentResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*computeElasticIPServerAttachmentResource)(nil)
 )
 
 type computeElasticIPServerAttachmentResourceData struct {
@@ -132,19 +133,35 @@ func (c computeElasticIPServerAttachmentResource) Read(ctx context.Context, requ
 	}
 
 	server, err := compute.NewServerService(c.client).Get(ctx, int(state.ServerID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get server: %s", err))
 		return
 	}
 
-	elasticIP, diagnostics := findComputeElasticIP(ctx, c.elasticIPService, int(state.ElasticIPID.Value))
-	response.Diagnostics.Append(diagnostics...)
-	if response.Diagnostics.HasError() {
+	elasticIP, err := findComputeElasticIP(ctx, c.elasticIPService, int(state.ElasticIPID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to find elastic ip: %s", err))
 		return
 	}
 
 	state.FromEntity(server, elasticIP)
 
+	// the elastic ip has been detached outside of terraform
+	if state.NetworkInterfaceID.Null {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
 }
@@ -167,3 +184,7 @@ func (c computeElasticIPServerAttachmentResource) Delete(ctx context.Context, re
 		return
 	}
 }
+
+func (c computeElasticIPServerAttachmentResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "server_id", "elastic_ip_id")
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -136,7 +135,7 @@ func (c computeKeyPairResource) Read(ctx context.Context, request tfsdk.ReadReso
 		}
 	}
 
-	response.Diagnostics.AddError("Not Found", fmt.Sprintf("key pair with id %d not found", state.ID.Value))
+	response.State.RemoveResource(ctx)
 }
 
 func (c computeKeyPairResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
@@ -159,5 +158,5 @@ func (c computeKeyPairResource) Delete(ctx context.Context, request tfsdk.Delete
 }
 
 func (c computeKeyPairResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
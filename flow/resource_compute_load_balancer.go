@@ -3,11 +3,11 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient/common"
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -18,12 +18,20 @@ var (
 	_ tfsdk.ResourceWithImportState = (*computeLoadBalancerResource)(nil)
 )
 
+var computeLoadBalancerTimeouts = resourceTimeouts{
+	Create: 30 * time.Minute,
+	Update: 20 * time.Minute,
+	Delete: 20 * time.Minute,
+}
+
 type computeLoadBalancerResourceData struct {
 	ID         types.Int64  `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
 	LocationID types.Int64  `tfsdk:"location_id"`
 	NetworkID  types.Int64  `tfsdk:"network_id"`
 	PrivateIP  types.String `tfsdk:"private_ip"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (c *computeLoadBalancerResourceData) FromEntity(loadBalancer compute.LoadBalancer) {
@@ -81,6 +89,7 @@ func (c computeLoadBalancerResourceType) GetSchema(ctx context.Context) (tfsdk.S
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"timeouts": computeLoadBalancerTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -109,6 +118,15 @@ func (c computeLoadBalancerResource) Create(ctx context.Context, request tfsdk.C
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeLoadBalancerTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := compute.LoadBalancerCreate{
 		Name:             config.Name.Value,
 		LocationID:       int(config.LocationID.Value),
@@ -123,9 +141,9 @@ func (c computeLoadBalancerResource) Create(ctx context.Context, request tfsdk.C
 		return
 	}
 
-	order, err := c.orderService.WaitUntilProcessed(ctx, ordering)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("waiting for load balancer creation: %s", err))
+	order, diagnostics := waitForOrder(ctx, c.orderService, ordering)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
@@ -135,14 +153,14 @@ func (c computeLoadBalancerResource) Create(ctx context.Context, request tfsdk.C
 		return
 	}
 
-	err = c.loadBalancerService.WaitUntilMutable(ctx, loadBalancer.ID)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("waiting for load balancer to be mutable: %s", err))
+	response.Diagnostics.Append(waitForLoadBalancerMutable(ctx, c.loadBalancerService, loadBalancer.ID)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
 	var state computeLoadBalancerResourceData
 	state.FromEntity(loadBalancer)
+	state.Timeouts = config.Timeouts
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -155,6 +173,11 @@ func (c computeLoadBalancerResource) Read(ctx context.Context, request tfsdk.Rea
 	}
 
 	loadBalancer, err := c.loadBalancerService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get load balancer: %s", err))
 		return
@@ -178,6 +201,15 @@ func (c computeLoadBalancerResource) Update(ctx context.Context, request tfsdk.U
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeLoadBalancerTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	update := compute.LoadBalancerUpdate{
 		Name: config.Name.Value,
 	}
@@ -189,6 +221,7 @@ func (c computeLoadBalancerResource) Update(ctx context.Context, request tfsdk.U
 	}
 
 	state.FromEntity(loadBalancer)
+	state.Timeouts = config.Timeouts
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -200,13 +233,43 @@ func (c computeLoadBalancerResource) Delete(ctx context.Context, request tfsdk.D
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeLoadBalancerTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	err := c.loadBalancerService.Delete(ctx, int(state.ID.Value))
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete load balancer: %s", err))
 		return
 	}
+
+	diagnostics = waitForDeletion(ctx, fmt.Sprintf("load balancer %d", state.ID.Value), func(ctx context.Context) error {
+		_, err := c.loadBalancerService.Get(ctx, int(state.ID.Value))
+		return err
+	})
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (c computeLoadBalancerResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
+}
+
+// waitForLoadBalancerMutable waits until the load balancer has finished applying previous changes and accepts further
+// modifications.
+func waitForLoadBalancerMutable(ctx context.Context, loadBalancerService compute.LoadBalancerService, loadBalancerID int) diag.Diagnostics {
+	return waitForCondition(ctx, fmt.Sprintf("load balancer %d to become mutable", loadBalancerID), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		loadBalancer, err := loadBalancerService.Get(ctx, loadBalancerID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get load balancer: %s", err))
+			return
+		}
+
+		done = loadBalancer.Status.ID != compute.LoadBalancerStatusWorking
+		return
+	})
 }
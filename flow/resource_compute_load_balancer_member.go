@@ -3,6 +3,7 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/compute"
@@ -14,10 +15,17 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*computeLoadBalancerMemberResourceType)(nil)
-	_ tfsdk.Resource     = (*computeLoadBalancerMemberResource)(nil)
+	_ tfsdk.ResourceType            = (*computeLoadBalancerMemberResourceType)(nil)
+	_ tfsdk.Resource                = (*computeLoadBalancerMemberResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*computeLoadBalancerMemberResource)(nil)
 )
 
+var computeLoadBalancerMemberTimeouts = resourceTimeouts{
+	Create: 20 * time.Minute,
+	Update: 20 * time.Minute,
+	Delete: 20 * time.Minute,
+}
+
 type computeLoadBalancerMemberResourceData struct {
 	ID             types.Int64 `tfsdk:"id"`
 	PoolID         types.Int64 `tfsdk:"pool_id"`
@@ -28,6 +36,8 @@ type computeLoadBalancerMemberResourceData struct {
 	Port    types.Int64  `tfsdk:"port"`
 
 	// TODO status
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (c *computeLoadBalancerMemberResourceData) FromEntity(loadBalancerID, poolID int, member compute.LoadBalancerMember) {
@@ -98,6 +108,7 @@ func (c computeLoadBalancerMemberResourceType) GetSchema(ctx context.Context) (t
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"timeouts": computeLoadBalancerMemberTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -125,6 +136,15 @@ func (c computeLoadBalancerMemberResource) Create(ctx context.Context, request t
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeLoadBalancerMemberTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	loadBalancerID := int(config.LoadBalancerID.Value)
 	poolID := int(config.PoolID.Value)
 
@@ -140,14 +160,15 @@ func (c computeLoadBalancerMemberResource) Create(ctx context.Context, request t
 		return
 	}
 
-	err = c.loadBalancerService.WaitUntilMutable(ctx, loadBalancerID)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to wait until load balancer is mutable: %s", err))
+	// changes to pools and members are applied as an update of the load balancer itself
+	response.Diagnostics.Append(waitForLoadBalancerMutable(ctx, c.loadBalancerService, loadBalancerID)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
 	var state computeLoadBalancerMemberResourceData
 	state.FromEntity(loadBalancerID, poolID, member)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -165,12 +186,22 @@ func (c computeLoadBalancerMemberResource) Read(ctx context.Context, request tfs
 	poolID := int(state.PoolID.Value)
 
 	list, err := c.loadBalancerService.Pools(loadBalancerID).Members(poolID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list load balancer members: %s", err))
 		return
 	}
 
 	member, err := filter.FindOne(state, list.Items)
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Not Found", fmt.Sprintf("unable to find load balancer member: %s", err))
 		return
@@ -183,7 +214,25 @@ func (c computeLoadBalancerMemberResource) Read(ctx context.Context, request tfs
 }
 
 func (c computeLoadBalancerMemberResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
-	response.Diagnostics.AddError("Not Supported", "updating a load balancer member is not supported")
+	var state computeLoadBalancerMemberResourceData
+	diagnostics := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var config computeLoadBalancerMemberResourceData
+	diagnostics = request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// all other attributes require the member to be replaced
+	state.Timeouts = config.Timeouts
+
+	diagnostics = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (c computeLoadBalancerMemberResource) Delete(ctx context.Context, request tfsdk.DeleteResourceRequest, response *tfsdk.DeleteResourceResponse) {
@@ -194,6 +243,15 @@ func (c computeLoadBalancerMemberResource) Delete(ctx context.Context, request t
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeLoadBalancerMemberTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	loadBalancerID := int(state.LoadBalancerID.Value)
 	poolID := int(state.PoolID.Value)
 	memberID := int(state.ID.Value)
@@ -204,9 +262,13 @@ func (c computeLoadBalancerMemberResource) Delete(ctx context.Context, request t
 		return
 	}
 
-	err = c.loadBalancerService.WaitUntilMutable(ctx, loadBalancerID)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to wait until load balancer is mutable: %s", err))
+	// changes to pools and members are applied as an update of the load balancer itself
+	response.Diagnostics.Append(waitForLoadBalancerMutable(ctx, c.loadBalancerService, loadBalancerID)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 }
+
+func (c computeLoadBalancerMemberResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "load_balancer_id", "pool_id", "id")
+}
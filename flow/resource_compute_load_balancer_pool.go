@@ -12,10 +12,17 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*computeLoadBalancerPoolResourceType)(nil)
-	_ tfsdk.Resource     = (*computeLoadBalancerPoolResource)(nil)
+	_ tfsdk.ResourceType            = (*computeLoadBalancerPoolResourceType)(nil)
+	_ tfsdk.Resource                = (*computeLoadBalancerPoolResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*computeLoadBalancerPoolResource)(nil)
 )
 
+var computeLoadBalancerPoolTimeouts = resourceTimeouts{
+	Create: 20 * time.Minute,
+	Update: 20 * time.Minute,
+	Delete: 20 * time.Minute,
+}
+
 type computeLoadBalancerHTTPHealthCheckResourceData struct {
 	Method types.String `tfsdk:"method"`
 	Path   types.String `tfsdk:"path"`
@@ -48,6 +55,8 @@ type computeLoadBalancerPoolResourceData struct {
 	CertificateID types.Int64 `tfsdk:"certificate_id"`
 
 	HealthCheck *computeLoadBalancerHealthCheckResourceData `tfsdk:"health_check"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (c *computeLoadBalancerPoolResourceData) FromEntity(loadBalancerID int, pool compute.LoadBalancerPool) {
@@ -227,6 +236,7 @@ func (c computeLoadBalancerPoolResourceType) GetSchema(ctx context.Context) (tfs
 				}),
 				Required: true,
 			},
+			"timeouts": computeLoadBalancerPoolTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -260,6 +270,15 @@ func (c computeLoadBalancerPoolResource) Create(ctx context.Context, request tfs
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeLoadBalancerPoolTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	loadBalancerID := int(config.LoadBalancerID.Value)
 
 	create := compute.LoadBalancerPoolCreate{
@@ -278,14 +297,15 @@ func (c computeLoadBalancerPoolResource) Create(ctx context.Context, request tfs
 		return
 	}
 
-	err = c.loadBalancerService.WaitUntilMutable(ctx, loadBalancerID)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to wait until load balancer is mutable: %s", err))
+	// changes to pools and members are applied as an update of the load balancer itself
+	response.Diagnostics.Append(waitForLoadBalancerMutable(ctx, c.loadBalancerService, loadBalancerID)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
 	var state computeLoadBalancerPoolResourceData
 	state.FromEntity(loadBalancerID, pool)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -302,6 +322,11 @@ func (c computeLoadBalancerPoolResource) Read(ctx context.Context, request tfsdk
 	loadBalancerID := int(state.LoadBalancerID.Value)
 
 	pool, err := c.loadBalancerService.Pools(loadBalancerID).Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get load balancer pool: %s", err))
 		return
@@ -334,6 +359,15 @@ func (c computeLoadBalancerPoolResource) Update(ctx context.Context, request tfs
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeLoadBalancerPoolTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	loadBalancerID := int(state.LoadBalancerID.Value)
 	poolID := int(state.ID.Value)
 
@@ -350,13 +384,14 @@ func (c computeLoadBalancerPoolResource) Update(ctx context.Context, request tfs
 		return
 	}
 
-	err = c.loadBalancerService.WaitUntilMutable(ctx, loadBalancerID)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to wait until load balancer is mutable: %s", err))
+	// changes to pools and members are applied as an update of the load balancer itself
+	response.Diagnostics.Append(waitForLoadBalancerMutable(ctx, c.loadBalancerService, loadBalancerID)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
 	state.FromEntity(loadBalancerID, pool)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -370,6 +405,15 @@ func (c computeLoadBalancerPoolResource) Delete(ctx context.Context, request tfs
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeLoadBalancerPoolTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	loadBalancerID := int(state.LoadBalancerID.Value)
 	poolID := int(state.ID.Value)
 
@@ -379,9 +423,9 @@ func (c computeLoadBalancerPoolResource) Delete(ctx context.Context, request tfs
 		return
 	}
 
-	err = c.loadBalancerService.WaitUntilMutable(ctx, loadBalancerID)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to wait until load balancer is mutable: %s", err))
+	// changes to pools and members are applied as an update of the load balancer itself
+	response.Diagnostics.Append(waitForLoadBalancerMutable(ctx, c.loadBalancerService, loadBalancerID)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 }
@@ -425,3 +469,7 @@ func convertHealthCheckConfigToAPIOptions(config computeLoadBalancerHealthCheckR
 
 	return
 }
+
+func (c computeLoadBalancerPoolResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "load_balancer_id", "id")
+}
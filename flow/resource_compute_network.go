@@ -3,10 +3,10 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -17,6 +17,12 @@ var (
 	_ tfsdk.ResourceWithImportState = (*computeNetworkResource)(nil)
 )
 
+var computeNetworkTimeouts = resourceTimeouts{
+	Create: 5 * time.Minute,
+	Update: 5 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 type computeNetworkResourceAllocationPool struct {
 	Start types.String `tfsdk:"start"`
 	End   types.String `tfsdk:"end"`
@@ -30,6 +36,8 @@ type computeNetworkResourceData struct {
 	DomainNameServers []types.String                        `tfsdk:"domain_name_servers"`
 	AllocationPool    *computeNetworkResourceAllocationPool `tfsdk:"allocation_pool"`
 	GatewayIP         types.String                          `tfsdk:"gateway_ip"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (c *computeNetworkResourceData) FromEntity(network compute.Network) {
@@ -124,6 +132,7 @@ func (c computeNetworkResourceType) GetSchema(ctx context.Context) (tfsdk.Schema
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"timeouts": computeNetworkTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -151,6 +160,15 @@ func (c computeNetworkResource) Create(ctx context.Context, request tfsdk.Create
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeNetworkTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := compute.NetworkCreate{
 		Name:       config.Name.Value,
 		LocationID: int(config.LocationID.Value),
@@ -181,6 +199,7 @@ func (c computeNetworkResource) Create(ctx context.Context, request tfsdk.Create
 
 	var state computeNetworkResourceData
 	state.FromEntity(network)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -195,6 +214,11 @@ func (c computeNetworkResource) Read(ctx context.Context, request tfsdk.ReadReso
 	}
 
 	network, err := c.networkService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get network: %s", err))
 		return
@@ -221,6 +245,15 @@ func (c computeNetworkResource) Update(ctx context.Context, request tfsdk.Update
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeNetworkTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	update := compute.NetworkUpdate{
 		Name:      config.Name.Value,
 		GatewayIP: config.GatewayIP.Value,
@@ -245,6 +278,7 @@ func (c computeNetworkResource) Update(ctx context.Context, request tfsdk.Update
 	}
 
 	state.FromEntity(network)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -258,13 +292,21 @@ func (c computeNetworkResource) Delete(ctx context.Context, request tfsdk.Delete
 		return
 	}
 
-	err := c.networkService.Delete(ctx, int(state.ID.Value))
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete network: %s", err))
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeNetworkTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	diagnostics = deleteOnceReleased(ctx, fmt.Sprintf("network %d", state.ID.Value), func(ctx context.Context) error {
+		return c.networkService.Delete(ctx, int(state.ID.Value))
+	})
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (c computeNetworkResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
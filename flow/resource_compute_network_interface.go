@@ -14,8 +14,9 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*computeNetworkInterfaceResourceType)(nil)
-	_ tfsdk.Resource     = (*computeNetworkInterfaceResource)(nil)
+	_ tfsdk.ResourceType            = (*computeNetworkInterfaceResourceType)(nil)
+	_ tfsdk.Resource                = (*computeNetworkInterfaceResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*computeNetworkInterfaceResource)(nil)
 )
 
 type computeNetworkInterfaceResourceData struct {
@@ -202,12 +203,22 @@ func (c computeNetworkInterfaceResource) Read(ctx context.Context, request tfsdk
 	serverID := int(state.ServerID.Value)
 
 	list, err := c.serverService.NetworkInterfaces(serverID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list network interfaces: %s", err))
 		return
 	}
 
 	iface, err := filter.FindOne(state, list.Items)
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Not Found", fmt.Sprintf("unable to find network interface: %s", err))
 		return
@@ -292,3 +303,7 @@ func (c computeNetworkInterfaceResource) Delete(ctx context.Context, request tfs
 		return
 	}
 }
+
+func (c computeNetworkInterfaceResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "server_id", "id")
+}
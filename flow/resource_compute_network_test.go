@@ -1,9 +1,12 @@
 package flow
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -33,6 +36,25 @@ func TestAccComputeNetwork_Basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeNetwork_Disappears(t *testing.T) {
+	networkName := acctest.RandomWithPrefix("test-network")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeNetworkConfigBasic, networkName, "192.168.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDisappears("flow_compute_network.foobar", func(ctx context.Context, client goclient.Client, id int) error {
+						return compute.NewNetworkService(client).Delete(ctx, id)
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 const testAccComputeNetworkConfigBasic = `
 resource "flow_compute_network" "foobar" {
 	name        = "%s"
@@ -6,7 +6,6 @@ import (
 
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -140,6 +139,11 @@ func (c computeRouterResource) Read(ctx context.Context, request tfsdk.ReadResou
 	}
 
 	router, err := c.routerService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get router: %s", err))
 		return
@@ -199,5 +203,5 @@ func (c computeRouterResource) Delete(ctx context.Context, request tfsdk.DeleteR
 }
 
 func (c computeRouterResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
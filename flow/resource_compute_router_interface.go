@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*computeRouterInterfaceResourceType)(nil)
-	_ tfsdk.Resource     = (*computeRouterInterfaceResource)(nil)
+	_ tfsdk.ResourceType            = (*computeRouterInterfaceResourceType)(nil)
+	_ tfsdk.Resource                = (*computeRouterInterfaceResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*computeRouterInterfaceResource)(nil)
 )
 
 type computeRouterInterfaceResourceData struct {
@@ -124,6 +125,11 @@ func (c computeRouterInterfaceResource) Read(ctx context.Context, request tfsdk.
 
 	routerID := int(state.RouterID.Value)
 	list, err := compute.NewRouterInterfaceService(c.client, routerID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list router interfaces: %s", err))
 		return
@@ -139,7 +145,7 @@ func (c computeRouterInterfaceResource) Read(ctx context.Context, request tfsdk.
 		}
 	}
 
-	response.Diagnostics.AddError("Not Found", "router interface could not be found")
+	response.State.RemoveResource(ctx)
 }
 
 func (c computeRouterInterfaceResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
@@ -161,3 +167,7 @@ func (c computeRouterInterfaceResource) Delete(ctx context.Context, request tfsd
 		return
 	}
 }
+
+func (c computeRouterInterfaceResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "router_id", "id")
+}
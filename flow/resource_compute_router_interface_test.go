@@ -25,6 +25,12 @@ func TestAccComputeRouterInterface_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("flow_compute_router_interface.foobar", "private_ip"),
 				),
 			},
+			{
+				ResourceName:      "flow_compute_router_interface.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateID("flow_compute_router_interface.foobar", "router_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
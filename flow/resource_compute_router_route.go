@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*computeRouterRouteResourceType)(nil)
-	_ tfsdk.Resource     = (*computeRouterRouteResource)(nil)
+	_ tfsdk.ResourceType            = (*computeRouterRouteResourceType)(nil)
+	_ tfsdk.Resource                = (*computeRouterRouteResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*computeRouterRouteResource)(nil)
 )
 
 type computeRouterRouteResourceData struct {
@@ -124,6 +125,11 @@ func (c computeRouterRouteResource) Read(ctx context.Context, request tfsdk.Read
 	routerID := int(state.RouterID.Value)
 
 	list, err := compute.NewRouteService(c.client, routerID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list routes: %s", err))
 		return
@@ -139,7 +145,7 @@ func (c computeRouterRouteResource) Read(ctx context.Context, request tfsdk.Read
 		}
 	}
 
-	response.Diagnostics.AddError("Not Found", fmt.Sprintf("route with id %d not found", state.ID.Value))
+	response.State.RemoveResource(ctx)
 }
 
 func (c computeRouterRouteResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
@@ -161,3 +167,7 @@ func (c computeRouterRouteResource) Delete(ctx context.Context, request tfsdk.De
 		return
 	}
 }
+
+func (c computeRouterRouteResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "router_id", "id")
+}
@@ -3,10 +3,10 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -17,10 +17,18 @@ var (
 	_ tfsdk.ResourceWithImportState = (*computeSecurityGroupResource)(nil)
 )
 
+var computeSecurityGroupTimeouts = resourceTimeouts{
+	Create: 5 * time.Minute,
+	Update: 5 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 type computeSecurityGroupResourceData struct {
 	ID         types.Int64  `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
 	LocationID types.Int64  `tfsdk:"location_id"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (c *computeSecurityGroupResourceData) FromEntity(securityGroup compute.SecurityGroup) {
@@ -55,6 +63,7 @@ func (c computeSecurityGroupResourceType) GetSchema(ctx context.Context) (tfsdk.
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"timeouts": computeSecurityGroupTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -82,6 +91,15 @@ func (c computeSecurityGroupResource) Create(ctx context.Context, request tfsdk.
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeSecurityGroupTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := compute.SecurityGroupCreate{
 		Name:       config.Name.Value,
 		LocationID: int(config.LocationID.Value),
@@ -95,6 +113,7 @@ func (c computeSecurityGroupResource) Create(ctx context.Context, request tfsdk.
 
 	var state computeSecurityGroupResourceData
 	state.FromEntity(securityGroup)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -109,6 +128,11 @@ func (c computeSecurityGroupResource) Read(ctx context.Context, request tfsdk.Re
 	}
 
 	securityGroup, err := c.securityGroupService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list security groups: %s", err))
 		return
@@ -135,6 +159,15 @@ func (c computeSecurityGroupResource) Update(ctx context.Context, request tfsdk.
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeSecurityGroupTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	update := compute.SecurityGroupUpdate{
 		Name: config.Name.Value,
 	}
@@ -146,6 +179,7 @@ func (c computeSecurityGroupResource) Update(ctx context.Context, request tfsdk.
 	}
 
 	state.FromEntity(securityGroup)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -159,13 +193,21 @@ func (c computeSecurityGroupResource) Delete(ctx context.Context, request tfsdk.
 		return
 	}
 
-	err := c.securityGroupService.Delete(ctx, int(state.ID.Value))
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete security group: %s", err))
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeSecurityGroupTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	diagnostics = deleteOnceReleased(ctx, fmt.Sprintf("security group %d", state.ID.Value), func(ctx context.Context) error {
+		return c.securityGroupService.Delete(ctx, int(state.ID.Value))
+	})
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (c computeSecurityGroupResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
@@ -16,6 +16,7 @@ import (
 var (
 	_ tfsdk.ResourceType                 = (*computeSecurityGroupRuleResourceType)(nil)
 	_ tfsdk.Resource                     = (*computeSecurityGroupRuleResource)(nil)
+	_ tfsdk.ResourceWithImportState      = (*computeSecurityGroupRuleResource)(nil)
 	_ tfsdk.ResourceWithConfigValidators = (*computeSecurityGroupRuleResource)(nil)
 )
 
@@ -278,6 +279,11 @@ func (c computeSecurityGroupRuleResource) Read(ctx context.Context, request tfsd
 	ruleID := int(state.ID.Value)
 
 	list, err := c.securityGroupService.Rules(securityGroupID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list security group rules: %s", err))
 		return
@@ -293,7 +299,7 @@ func (c computeSecurityGroupRuleResource) Read(ctx context.Context, request tfsd
 		}
 	}
 
-	response.Diagnostics.AddError("Not Found", fmt.Sprintf("security group rule %d could not be found", ruleID))
+	response.State.RemoveResource(ctx)
 }
 
 func (c computeSecurityGroupRuleResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
@@ -367,3 +373,7 @@ func (c computeSecurityGroupRuleResource) ConfigValidators(ctx context.Context)
 		validators.MutuallyExclusive("ip_range", "remote_security_group_id"),
 	}
 }
+
+func (c computeSecurityGroupRuleResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "security_group_id", "id")
+}
@@ -3,32 +3,69 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/common"
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/validators"
 )
 
 var (
-	_ tfsdk.ResourceType            = (*computeServerResourceType)(nil)
-	_ tfsdk.Resource                = (*computeServerResource)(nil)
-	_ tfsdk.ResourceWithImportState = (*computeServerResource)(nil)
+	_ tfsdk.ResourceType                 = (*computeServerResourceType)(nil)
+	_ tfsdk.Resource                     = (*computeServerResource)(nil)
+	_ tfsdk.ResourceWithImportState      = (*computeServerResource)(nil)
+	_ tfsdk.ResourceWithConfigValidators = (*computeServerResource)(nil)
+	_ tfsdk.ResourceWithModifyPlan       = (*computeServerResource)(nil)
 )
 
+var computeServerTimeouts = resourceTimeouts{
+	Create: 30 * time.Minute,
+	Update: 30 * time.Minute,
+	Delete: 15 * time.Minute,
+}
+
+const (
+	computeServerPowerStateRunning = "running"
+	computeServerPowerStateStopped = "stopped"
+)
+
+var computeServerReferences = attributeReferences{
+	locationReference("location_id", "location"),
+	computeImageReference("image_id", "image"),
+	productReference("product_id", "product", "compute-engine-vm", false),
+}
+
 type computeServerResourceData struct {
 	ID         types.Int64  `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
 	LocationID types.Int64  `tfsdk:"location_id"`
+	Location   types.String `tfsdk:"location"`
 	ImageID    types.Int64  `tfsdk:"image_id"`
+	Image      types.String `tfsdk:"image"`
 	ProductID  types.Int64  `tfsdk:"product_id"`
+	Product    types.String `tfsdk:"product"`
 	NetworkID  types.Int64  `tfsdk:"network_id"`
 	PrivateIP  types.String `tfsdk:"private_ip"`
 	KeyPairID  types.Int64  `tfsdk:"key_pair_id"`
 	Password   types.String `tfsdk:"password"`
 	CloudInit  types.String `tfsdk:"cloud_init"`
+
+	AttachElasticIP           types.Bool                                  `tfsdk:"attach_elastic_ip"`
+	DeleteElasticIPsOnDestroy types.Bool                                  `tfsdk:"delete_elastic_ips_on_destroy"`
+	PublicIP                  types.String                                `tfsdk:"public_ip"`
+	NetworkInterfaces         []computeServerNetworkInterfaceResourceData `tfsdk:"network_interfaces"`
+
+	PowerState    types.String `tfsdk:"power_state"`
+	RebootTrigger types.String `tfsdk:"reboot_trigger"`
+
+	OrderID  types.Int64           `tfsdk:"order_id"`
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (c *computeServerResourceData) FromEntity(server compute.Server) {
@@ -37,12 +74,70 @@ func (c *computeServerResourceData) FromEntity(server compute.Server) {
 	c.LocationID = types.Int64{Value: int64(server.Location.ID)}
 	c.ImageID = types.Int64{Value: int64(server.Image.ID)}
 	c.ProductID = types.Int64{Value: int64(server.Product.ID)}
-	c.KeyPairID = types.Int64{Value: int64(server.KeyPair.ID)}
+
+	if server.KeyPair.ID != 0 {
+		c.KeyPairID = types.Int64{Value: int64(server.KeyPair.ID)}
+	} else {
+		c.KeyPairID = types.Int64{Null: true}
+	}
+
+	c.PowerState = types.String{Value: computeServerPowerState(server.Status)}
 
 	if len(server.Networks) != 0 {
 		network := server.Networks[0]
 		c.NetworkID = types.Int64{Value: int64(network.ID)}
-		c.PrivateIP = types.String{Value: network.Interfaces[0].PrivateIP}
+
+		if len(network.Interfaces) > 0 {
+			c.PrivateIP = types.String{Value: network.Interfaces[0].PrivateIP}
+		}
+	}
+
+	// the interfaces of a network are missing while the server is being provisioned, a known private ip is retained
+	if c.PrivateIP.Value == "" {
+		c.PrivateIP = types.String{Null: true}
+	}
+
+	c.PublicIP = types.String{Null: true}
+	for _, network := range server.Networks {
+		for _, iface := range network.Interfaces {
+			if iface.PublicIP != "" && c.PublicIP.Null {
+				c.PublicIP = types.String{Value: iface.PublicIP}
+			}
+		}
+	}
+}
+
+func (c *computeServerResourceData) FromNetworkInterfaces(interfaces []compute.NetworkInterface) {
+	c.NetworkInterfaces = make([]computeServerNetworkInterfaceResourceData, len(interfaces))
+	for idx, iface := range interfaces {
+		c.NetworkInterfaces[idx].FromEntity(iface)
+	}
+}
+
+type computeServerNetworkInterfaceResourceData struct {
+	ID         types.Int64  `tfsdk:"id"`
+	NetworkID  types.Int64  `tfsdk:"network_id"`
+	PrivateIP  types.String `tfsdk:"private_ip"`
+	MacAddress types.String `tfsdk:"mac_address"`
+}
+
+func (c *computeServerNetworkInterfaceResourceData) FromEntity(iface compute.NetworkInterface) {
+	c.ID = types.Int64{Value: int64(iface.ID)}
+	c.NetworkID = types.Int64{Value: int64(iface.Network.ID)}
+	c.PrivateIP = types.String{Value: iface.PrivateIP}
+	c.MacAddress = types.String{Value: iface.MacAddress}
+}
+
+// computeServerPowerState converts the status of a server into its power state. Transitional statuses are reported
+// by their key, which causes a difference to the configured power state.
+func computeServerPowerState(status compute.ServerStatus) string {
+	switch status.ID {
+	case compute.ServerStatusRunning:
+		return computeServerPowerStateRunning
+	case compute.ServerStatusStopped:
+		return computeServerPowerStateStopped
+	default:
+		return status.Key
 	}
 }
 
@@ -59,6 +154,14 @@ func (c computeServerResourceType) GetSchema(ctx context.Context) (tfsdk.Schema,
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"order_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the order which created the server",
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 			"name": {
 				Type:                types.StringType,
 				MarkdownDescription: "name of the server",
@@ -66,33 +169,50 @@ func (c computeServerResourceType) GetSchema(ctx context.Context) (tfsdk.Schema,
 			},
 			"location_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the location",
-				Required:            true,
+				MarkdownDescription: "unique identifier of the location, conflicts with `location`",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"location": {
+				Type:                types.StringType,
+				MarkdownDescription: "key of the location (e.g. `ALP1`), conflicts with `location_id`",
+				Optional:            true,
+			},
 			"image_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the image",
-				Required:            true,
+				MarkdownDescription: "unique identifier of the image, conflicts with `image`. Changing the image recreates the server, as the api does not support rebuilding a server with another image",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"image": {
+				Type:                types.StringType,
+				MarkdownDescription: "key of the image (e.g. `linux-ubuntu-22.04-lts`), conflicts with `image_id`. Changing the image recreates the server",
+				Optional:            true,
+			},
 			"product_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the product",
-				Required:            true,
-				PlanModifiers: tfsdk.AttributePlanModifiers{
-					tfsdk.RequiresReplace(),
-				},
+				MarkdownDescription: "unique identifier of the product, conflicts with `product` (changes upgrade the server in place)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"product": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the product (e.g. `b1.1x1`), conflicts with `product_id`",
+				Optional:            true,
 			},
 			"network_id": {
 				Type:                types.Int64Type,
 				MarkdownDescription: "unique identifier of the initial network",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
 					tfsdk.RequiresReplace(),
 				},
 			},
@@ -130,6 +250,74 @@ func (c computeServerResourceType) GetSchema(ctx context.Context) (tfsdk.Schema,
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"attach_elastic_ip": {
+				Type:                types.BoolType,
+				MarkdownDescription: "attach a new elastic ip to the server during creation",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"delete_elastic_ips_on_destroy": {
+				Type:                types.BoolType,
+				MarkdownDescription: "release all elastic ips attached to the server when it is destroyed",
+				Optional:            true,
+			},
+			"public_ip": {
+				Type:                types.StringType,
+				MarkdownDescription: "public ip of the server if an elastic ip is attached",
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"network_interfaces": {
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:                types.Int64Type,
+						MarkdownDescription: "unique identifier of the network interface",
+						Computed:            true,
+					},
+					"network_id": {
+						Type:                types.Int64Type,
+						MarkdownDescription: "unique identifier of the network",
+						Computed:            true,
+					},
+					"private_ip": {
+						Type:                types.StringType,
+						MarkdownDescription: "private ip of the network interface",
+						Computed:            true,
+					},
+					"mac_address": {
+						Type:                types.StringType,
+						MarkdownDescription: "mac address of the network interface",
+						Computed:            true,
+					},
+				}),
+				MarkdownDescription: "all network interfaces of the server",
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"power_state": {
+				Type:                types.StringType,
+				MarkdownDescription: "power state of the server (`running` or `stopped`)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.OneOf(computeServerPowerStateRunning, computeServerPowerStateStopped),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"reboot_trigger": {
+				Type:                types.StringType,
+				MarkdownDescription: "arbitrary value which causes a reboot of the running server when changed. Setting the first value, e.g. on an existing or imported server, only arms the trigger without rebooting the server",
+				Optional:            true,
+			},
+			"timeouts": computeServerTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -141,14 +329,18 @@ func (c computeServerResourceType) NewResource(ctx context.Context, p tfsdk.Prov
 	}
 
 	return computeServerResource{
-		serverService: compute.NewServerService(prov.client),
-		orderService:  common.NewOrderService(prov.client),
+		client:         prov.client,
+		serverService:  compute.NewServerService(prov.client),
+		orderService:   common.NewOrderService(prov.client),
+		productService: common.NewProductService(prov.client),
 	}, diagnostics
 }
 
 type computeServerResource struct {
-	serverService compute.ServerService
-	orderService  common.OrderService
+	client         goclient.Client
+	serverService  compute.ServerService
+	orderService   common.OrderService
+	productService common.ProductService
 }
 
 func (c computeServerResource) Create(ctx context.Context, request tfsdk.CreateResourceRequest, response *tfsdk.CreateResourceResponse) {
@@ -158,12 +350,29 @@ func (c computeServerResource) Create(ctx context.Context, request tfsdk.CreateR
 		return
 	}
 
+	// identifiers configured through their references are only known in the plan
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("location_id"), &config.LocationID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("image_id"), &config.ImageID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("product_id"), &config.ProductID)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeServerTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := compute.ServerCreate{
 		Name:             config.Name.Value,
 		LocationID:       int(config.LocationID.Value),
 		ImageID:          int(config.ImageID.Value),
 		ProductID:        int(config.ProductID.Value),
-		AttachExternalIP: false,
+		AttachExternalIP: config.AttachElasticIP.Value,
 		NetworkID:        int(config.NetworkID.Value),
 		PrivateIP:        config.PrivateIP.Value,
 		KeyPairID:        int(config.KeyPairID.Value),
@@ -177,9 +386,15 @@ func (c computeServerResource) Create(ctx context.Context, request tfsdk.CreateR
 		return
 	}
 
-	order, err := c.orderService.WaitUntilProcessed(ctx, ordering)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("waiting for server creation: %s", err))
+	// keep track of the server in case waiting for its order fails or is interrupted
+	response.Diagnostics.Append(setOrderedState(ctx, request.Plan, &response.State, ordering)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	order, diagnostics := waitForOrder(ctx, c.orderService, ordering)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
@@ -189,11 +404,33 @@ func (c computeServerResource) Create(ctx context.Context, request tfsdk.CreateR
 		return
 	}
 
+	// the power state is read from the configuration, where it is null instead of unknown if it is not configured
+	if !config.PowerState.Null && !config.PowerState.Unknown && config.PowerState.Value != computeServerPowerState(server.Status) {
+		server, diagnostics = c.changePowerState(ctx, server.ID, config.PowerState.Value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var state computeServerResourceData
 	state.FromEntity(server)
 
+	response.Diagnostics.Append(c.readNetworkInterfaces(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.Location = config.Location
+	state.Image = config.Image
+	state.Product = config.Product
+	state.AttachElasticIP = config.AttachElasticIP
+	state.DeleteElasticIPsOnDestroy = config.DeleteElasticIPsOnDestroy
 	state.Password = config.Password
 	state.CloudInit = config.CloudInit
+	state.RebootTrigger = config.RebootTrigger
+	state.OrderID = types.Int64{Value: int64(order.ID)}
+	state.Timeouts = config.Timeouts
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -205,7 +442,28 @@ func (c computeServerResource) Read(ctx context.Context, request tfsdk.ReadResou
 		return
 	}
 
+	if state.ID.Null {
+		// the creation of the server was interrupted, recover its identifier from the order
+		id, processed, diagnostics := getOrderedID(ctx, c.orderService, state.OrderID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() || !processed {
+			return
+		}
+
+		if id.Null {
+			response.State.RemoveResource(ctx)
+			return
+		}
+
+		state.ID = id
+	}
+
 	server, err := c.serverService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get server: %s", err))
 		return
@@ -213,6 +471,11 @@ func (c computeServerResource) Read(ctx context.Context, request tfsdk.ReadResou
 
 	state.FromEntity(server)
 
+	response.Diagnostics.Append(c.readNetworkInterfaces(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -224,11 +487,20 @@ func (c computeServerResource) Update(ctx context.Context, request tfsdk.UpdateR
 	}
 
 	var config computeServerResourceData
-	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &config)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeServerTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	update := compute.ServerUpdate{
 		Name: config.Name.Value,
 	}
@@ -239,8 +511,69 @@ func (c computeServerResource) Update(ctx context.Context, request tfsdk.UpdateR
 		return
 	}
 
+	if config.ProductID.Value != state.ProductID.Value {
+		upgrade := compute.ServerUpgrade{
+			ProductID: int(config.ProductID.Value),
+		}
+
+		ordering, err := c.serverService.Upgrade(ctx, server.ID, upgrade)
+		if err != nil {
+			response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to upgrade server: %s", err))
+			return
+		}
+
+		_, diagnostics = waitForOrder(ctx, c.orderService, ordering)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		server, diagnostics = c.waitForStatus(ctx, server.ID, "finish the upgrade", compute.ServerStatusRunning, compute.ServerStatusStopped)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the plan carries the prior power state if it is not configured, which may be a transitional status
+	var powerState types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("power_state"), &powerState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !powerState.Null && !powerState.Unknown && powerState.Value != computeServerPowerState(server.Status) {
+		server, diagnostics = c.changePowerState(ctx, server.ID, powerState.Value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// setting the first value of the trigger, e.g. on an existing or imported server, only arms it
+	rebootTriggered := !state.RebootTrigger.Null && !config.RebootTrigger.Null && !config.RebootTrigger.Equal(state.RebootTrigger)
+	if rebootTriggered && server.Status.ID == compute.ServerStatusRunning {
+		server, diagnostics = c.reboot(ctx, server.ID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	state.FromEntity(server)
 
+	response.Diagnostics.Append(c.readNetworkInterfaces(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.Location = config.Location
+	state.Image = config.Image
+	state.Product = config.Product
+	state.DeleteElasticIPsOnDestroy = config.DeleteElasticIPsOnDestroy
+	state.RebootTrigger = config.RebootTrigger
+	state.Timeouts = config.Timeouts
+
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -251,13 +584,208 @@ func (c computeServerResource) Delete(ctx context.Context, request tfsdk.DeleteR
 		return
 	}
 
-	err := c.serverService.Delete(ctx, int(state.ID.Value), false)
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeServerTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if state.ID.Null {
+		// the creation of the server was interrupted, wait for its order to find out whether it has to be deleted
+		id, diagnostics := waitForOrderedID(ctx, c.orderService, state.OrderID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() || id.Null {
+			return
+		}
+
+		state.ID = id
+	}
+
+	serverID := int(state.ID.Value)
+
+	err := c.serverService.Delete(ctx, serverID, state.DeleteElasticIPsOnDestroy.Value)
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete server: %s", err))
 		return
 	}
+
+	diagnostics = waitForDeletion(ctx, fmt.Sprintf("server %d", serverID), func(ctx context.Context) error {
+		_, err := c.serverService.Get(ctx, serverID)
+		return err
+	})
+	response.Diagnostics.Append(diagnostics...)
+}
+
+func (c computeServerResource) ConfigValidators(ctx context.Context) []tfsdk.ResourceConfigValidator {
+	return computeServerReferences.ConfigValidators()
+}
+
+func (c computeServerResource) ModifyPlan(ctx context.Context, request tfsdk.ModifyResourcePlanRequest, response *tfsdk.ModifyResourcePlanResponse) {
+	computeServerReferences.ModifyPlan(ctx, c.client, request, response)
+	if response.Diagnostics.HasError() || request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// an unconfigured power state carries the prior status, which may be transitional and change until it is applied
+	var powerState types.String
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("power_state"), &powerState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !powerState.Unknown && powerState.Value != computeServerPowerStateRunning && powerState.Value != computeServerPowerStateStopped {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("power_state"), types.String{Unknown: true})...)
+	}
+
+	var current, planned types.Int64
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("product_id"), &current)...)
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("product_id"), &planned)...)
+	if response.Diagnostics.HasError() || planned.Unknown || planned.Value == current.Value {
+		return
+	}
+
+	response.Diagnostics.Append(c.validateUpgrade(ctx, int(current.Value), int(planned.Value))...)
 }
 
 func (c computeServerResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
+}
+
+// readNetworkInterfaces stores all network interfaces of the server in the state, as the server itself only provides
+// a subset of their attributes.
+func (c computeServerResource) readNetworkInterfaces(ctx context.Context, state *computeServerResourceData) (diagnostics diag.Diagnostics) {
+	list, err := c.serverService.NetworkInterfaces(int(state.ID.Value)).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to list network interfaces: %s", err))
+		return
+	}
+
+	state.FromNetworkInterfaces(list.Items)
+	return
+}
+
+// changePowerState starts or stops the server depending on the requested power state.
+func (c computeServerResource) changePowerState(ctx context.Context, serverID int, powerState string) (compute.Server, diag.Diagnostics) {
+	switch powerState {
+	case computeServerPowerStateRunning:
+		return c.performAction(ctx, serverID, "start", compute.ServerStatusRunning)
+	case computeServerPowerStateStopped:
+		return c.performAction(ctx, serverID, "stop", compute.ServerStatusStopped)
+	}
+
+	var diagnostics diag.Diagnostics
+	diagnostics.AddError("Invalid Power State", fmt.Sprintf("unable to change the power state of server %d to %q", serverID, powerState))
+	return compute.Server{}, diagnostics
+}
+
+// performAction performs the action on the server and waits until the server has reached the given status.
+func (c computeServerResource) performAction(ctx context.Context, serverID int, action string, status int) (compute.Server, diag.Diagnostics) {
+	_, err := c.serverService.Perform(ctx, serverID, compute.ServerPerform{Action: action})
+	if err != nil {
+		var diagnostics diag.Diagnostics
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to %s server: %s", action, err))
+		return compute.Server{}, diagnostics
+	}
+
+	return c.waitForStatus(ctx, serverID, action, status)
+}
+
+// reboot reboots the running server and waits until it is running again. As the server is already running before the
+// reboot, it first waits until the server has left the running status to not return before the reboot has settled.
+// A fast reboot may not be observed at all, which is why leaving the running status is only waited on for a grace
+// period.
+func (c computeServerResource) reboot(ctx context.Context, serverID int) (compute.Server, diag.Diagnostics) {
+	_, err := c.serverService.Perform(ctx, serverID, compute.ServerPerform{Action: "reboot"})
+	if err != nil {
+		var diagnostics diag.Diagnostics
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to reboot server: %s", err))
+		return compute.Server{}, diagnostics
+	}
+
+	diagnostics := waitForTransition(ctx, fmt.Sprintf("server %d to begin the reboot", serverID), transitionGracePeriod, func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		server, err := c.serverService.Get(ctx, serverID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get server: %s", err))
+			return
+		}
+
+		return server.Status.ID != compute.ServerStatusRunning, nil
+	})
+	if diagnostics.HasError() {
+		return compute.Server{}, diagnostics
+	}
+
+	return c.waitForStatus(ctx, serverID, "reboot", compute.ServerStatusRunning)
+}
+
+// waitForStatus waits until the server has reached one of the given statuses. The operation describes what the server
+// is doing in the meantime and is included in the diagnostics.
+func (c computeServerResource) waitForStatus(ctx context.Context, serverID int, operation string, statuses ...int) (server compute.Server, diagnostics diag.Diagnostics) {
+	diagnostics = waitForCondition(ctx, fmt.Sprintf("server %d to %s", serverID, operation), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		var err error
+		server, err = c.serverService.Get(ctx, serverID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get server: %s", err))
+			return
+		}
+
+		if server.Status.ID == compute.ServerStatusError {
+			diagnostics.AddError("Server Error", fmt.Sprintf("server %d failed to %s", serverID, operation))
+			return
+		}
+
+		for _, status := range statuses {
+			if server.Status.ID == status {
+				done = true
+				return
+			}
+		}
+
+		return
+	})
+
+	return
+}
+
+// validateUpgrade ensures that the server can be upgraded from the current to the planned product. The platform only
+// supports upgrades to products of the same type which provide at least the resources of the current product.
+func (c computeServerResource) validateUpgrade(ctx context.Context, currentID int, plannedID int) (diagnostics diag.Diagnostics) {
+	current, err := c.productService.Get(ctx, currentID)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to get product: %s", err))
+		return
+	}
+
+	planned, err := c.productService.Get(ctx, plannedID)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to get product: %s", err))
+		return
+	}
+
+	if planned.Type.Key != current.Type.Key {
+		diagnostics.AddAttributeError(
+			path.Root("product_id"),
+			"Unsupported Product Change",
+			fmt.Sprintf("The server can not be changed to product %s of type %s.", planned.Name, planned.Type.Name),
+		)
+		return
+	}
+
+	for _, item := range current.Items {
+		for _, plannedItem := range planned.Items {
+			if plannedItem.ID == item.ID && plannedItem.Amount < item.Amount {
+				diagnostics.AddAttributeError(
+					path.Root("product_id"),
+					"Unsupported Product Change",
+					fmt.Sprintf("The server can not be downgraded from product %s to %s as it provides less %s. Please recreate the server instead.", current.Name, planned.Name, item.Name),
+				)
+				return
+			}
+		}
+	}
+
+	return
 }
@@ -0,0 +1,261 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/flowswiss/goclient/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeServer_PowerState(t *testing.T) {
+	serverName := acctest.RandomWithPrefix("test-server")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigPowerState, serverName, "running", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("flow_compute_server.foobar", "id"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "name", serverName),
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "location_id", "data.flow_location.foobar", "id"),
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "image_id", "data.flow_compute_image.foobar", "id"),
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "product_id", "data.flow_product.foobar", "id"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "power_state", "running"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "reboot_trigger", "initial"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigPowerState, serverName, "stopped", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "power_state", "stopped"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigPowerState, serverName, "running", "rebooted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "power_state", "running"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "reboot_trigger", "rebooted"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeServerConfigPowerState = `
+data "flow_location" "foobar" {
+	name = "ALP1"
+}
+
+data "flow_compute_image" "foobar" {
+	key = "linux-ubuntu-22.04-lts"
+}
+
+data "flow_product" "foobar" {
+	name = "b1.1x1"
+	type = "compute-engine-vm"
+}
+
+resource "flow_compute_server" "foobar" {
+	name     = "%s"
+	location = "ALP1"
+	image    = "linux-ubuntu-22.04-lts"
+	product  = "b1.1x1"
+
+	power_state    = "%s"
+	reboot_trigger = "%s"
+}
+`
+
+// TestAccComputeServer_DefaultPowerState makes sure that no action is performed on a server created without a power
+// state, as the api rejects actions which are not applicable to the current status of the server.
+func TestAccComputeServer_DefaultPowerState(t *testing.T) {
+	serverName := acctest.RandomWithPrefix("test-server")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigDefaultPowerState, serverName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "power_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeServerConfigDefaultPowerState = `
+resource "flow_compute_server" "foobar" {
+	name     = "%s"
+	location = "ALP1"
+	image    = "linux-ubuntu-22.04-lts"
+	product  = "b1.1x1"
+}
+`
+
+func TestAccComputeServer_Upgrade(t *testing.T) {
+	serverName := acctest.RandomWithPrefix("test-server")
+
+	var serverID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigUpgrade, serverName, "b1.1x1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "product_id", "data.flow_product.foobar", "id"),
+					resource.TestCheckResourceAttrWith("flow_compute_server.foobar", "id", func(value string) error {
+						serverID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigUpgrade, serverName, "b1.2x2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "product_id", "data.flow_product.foobar", "id"),
+					resource.TestCheckResourceAttrWith("flow_compute_server.foobar", "id", func(value string) error {
+						if value != serverID {
+							return fmt.Errorf("expected server %s to be upgraded in place, got server %s", serverID, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "power_state", "running"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccComputeServerConfigUpgrade, serverName, "b1.1x1"),
+				ExpectError: regexp.MustCompile(`can not be downgraded from product b1.2x2 to b1.1x1`),
+			},
+		},
+	})
+}
+
+const testAccComputeServerConfigUpgrade = `
+data "flow_product" "foobar" {
+	name = "%[2]s"
+	type = "compute-engine-vm"
+}
+
+resource "flow_compute_server" "foobar" {
+	name     = "%[1]s"
+	location = "ALP1"
+	image    = "linux-ubuntu-22.04-lts"
+	product  = "%[2]s"
+}
+`
+
+func TestAccComputeServer_ElasticIP(t *testing.T) {
+	serverName := acctest.RandomWithPrefix("test-server")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccComputeServerConfigElasticIP, serverName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "attach_elastic_ip", "true"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "delete_elastic_ips_on_destroy", "true"),
+					resource.TestCheckResourceAttrSet("flow_compute_server.foobar", "public_ip"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrSet("flow_compute_server.foobar", "network_interfaces.0.id"),
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "network_interfaces.0.network_id", "flow_compute_server.foobar", "network_id"),
+					resource.TestCheckResourceAttrPair("flow_compute_server.foobar", "network_interfaces.0.private_ip", "flow_compute_server.foobar", "private_ip"),
+					resource.TestCheckResourceAttrSet("flow_compute_server.foobar", "network_interfaces.0.mac_address"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeServerConfigElasticIP = `
+resource "flow_compute_server" "foobar" {
+	name     = "%s"
+	location = "ALP1"
+	image    = "linux-ubuntu-22.04-lts"
+	product  = "b1.1x1"
+
+	attach_elastic_ip             = true
+	delete_elastic_ips_on_destroy = true
+}
+`
+
+func TestAccComputeServer_InterruptedCreate(t *testing.T) {
+	if testAccFakeAPI == nil {
+		t.Skip("interrupting an order requires the fake api")
+	}
+
+	serverName := acctest.RandomWithPrefix("test-server")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccFakeAPI.holdOrders(serverName)
+				},
+				Config:      fmt.Sprintf(testAccComputeServerConfigInterruptedCreate, serverName, "2s"),
+				ExpectError: regexp.MustCompile("timeout while waiting for order"),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.releaseOrders(serverName)
+				},
+				Config: fmt.Sprintf(testAccComputeServerConfigInterruptedCreate, serverName, "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("flow_compute_server.foobar", "id"),
+					resource.TestCheckResourceAttrSet("flow_compute_server.foobar", "order_id"),
+					resource.TestCheckResourceAttr("flow_compute_server.foobar", "power_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeServerConfigInterruptedCreate = `
+resource "flow_compute_server" "foobar" {
+	name     = "%s"
+	location = "ALP1"
+	image    = "linux-ubuntu-22.04-lts"
+	product  = "b1.1x1"
+
+	timeouts = {
+		create = "%s"
+	}
+}
+`
+
+func TestComputeServerResource_ChangePowerState(t *testing.T) {
+	var c computeServerResource
+
+	// transitional statuses must never be treated as a request to start the server
+	_, diagnostics := c.changePowerState(context.Background(), 1, "stopping")
+	if !diagnostics.HasError() {
+		t.Error("expected error for transitional power state")
+	}
+}
+
+func TestComputeServerResourceData_FromEntity(t *testing.T) {
+	server := compute.Server{
+		ID:       1,
+		Networks: []compute.ServerNetworkAttachment{{Network: compute.Network{ID: 2}}},
+	}
+
+	// the network interfaces are missing while the server is being provisioned
+	var data computeServerResourceData
+	data.FromEntity(server)
+
+	if !data.PrivateIP.Null {
+		t.Errorf("expected null private ip for network without interfaces, got %q", data.PrivateIP.Value)
+	}
+
+	if data.NetworkID.Value != 2 {
+		t.Errorf("expected network id 2, got %d", data.NetworkID.Value)
+	}
+}
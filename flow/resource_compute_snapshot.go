@@ -3,10 +3,10 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -18,6 +18,12 @@ var (
 	_ tfsdk.ResourceWithImportState = (*computeSnapshotResource)(nil)
 )
 
+var computeSnapshotTimeouts = resourceTimeouts{
+	Create: 30 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 type computeSnapshotResourceData struct {
 	ID        types.Int64  `tfsdk:"id"`
 	Size      types.Int64  `tfsdk:"size"`
@@ -25,6 +31,8 @@ type computeSnapshotResourceData struct {
 
 	Name     types.String `tfsdk:"name"`
 	VolumeID types.Int64  `tfsdk:"volume_id"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (d *computeSnapshotResourceData) FromEntity(snapshot compute.Snapshot) {
@@ -73,6 +81,7 @@ func (t computeSnapshotResourceType) GetSchema(ctx context.Context) (tfsdk.Schem
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"timeouts": computeSnapshotTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -100,6 +109,15 @@ func (r computeSnapshotResource) Create(ctx context.Context, request tfsdk.Creat
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeSnapshotTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := compute.SnapshotCreate{
 		Name:     config.Name.Value,
 		VolumeID: int(config.VolumeID.Value),
@@ -119,11 +137,17 @@ func (r computeSnapshotResource) Create(ctx context.Context, request tfsdk.Creat
 		"data": snapshot,
 	})
 
+	state.Timeouts = config.Timeouts
+
 	if snapshot.Status.ID == compute.SnapshotStatusCreating {
 		// wait for the snapshot to be ready
-		waitForCondition(ctx, func(ctx context.Context) (bool, diag.Diagnostics) {
+		diagnostics = waitForCondition(ctx, fmt.Sprintf("snapshot %d to be created", snapshot.ID), func(ctx context.Context) (bool, diag.Diagnostics) {
 			return r.waitForSnapshotStatus(ctx, snapshot.ID)
 		})
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	diagnostics = response.State.Set(ctx, state)
@@ -139,6 +163,11 @@ func (r computeSnapshotResource) Read(ctx context.Context, request tfsdk.ReadRes
 	}
 
 	snapshot, err := r.snapshotService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get snapshot: %s", err))
 		return
@@ -165,27 +194,36 @@ func (r computeSnapshotResource) Update(ctx context.Context, request tfsdk.Updat
 		return
 	}
 
-	if config.Name.Equal(state.Name) {
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeSnapshotTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Debug(ctx, "snapshot name has changed: updating snapshot", map[string]interface{}{
-		"snapshot_id":    state.ID,
-		"previous_name":  state.Name,
-		"requested_name": config.Name,
-	})
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	update := compute.SnapshotUpdate{
-		Name: config.Name.Value,
-	}
+	if !config.Name.Equal(state.Name) {
+		tflog.Debug(ctx, "snapshot name has changed: updating snapshot", map[string]interface{}{
+			"snapshot_id":    state.ID,
+			"previous_name":  state.Name,
+			"requested_name": config.Name,
+		})
 
-	snapshot, err := r.snapshotService.Update(ctx, int(state.ID.Value), update)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to update snapshot: %s", err))
-		return
+		update := compute.SnapshotUpdate{
+			Name: config.Name.Value,
+		}
+
+		snapshot, err := r.snapshotService.Update(ctx, int(state.ID.Value), update)
+		if err != nil {
+			response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to update snapshot: %s", err))
+			return
+		}
+
+		state.FromEntity(snapshot)
 	}
 
-	state.FromEntity(snapshot)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -199,15 +237,30 @@ func (r computeSnapshotResource) Delete(ctx context.Context, request tfsdk.Delet
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeSnapshotTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	err := r.snapshotService.Delete(ctx, int(state.ID.Value))
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete snapshot: %s", err))
 		return
 	}
+
+	diagnostics = waitForDeletion(ctx, fmt.Sprintf("snapshot %d", state.ID.Value), func(ctx context.Context) error {
+		_, err := r.snapshotService.Get(ctx, int(state.ID.Value))
+		return err
+	})
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (r computeSnapshotResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
 
 func (r computeSnapshotResource) waitForSnapshotStatus(ctx context.Context, snapshotID int) (done bool, diagnostics diag.Diagnostics) {
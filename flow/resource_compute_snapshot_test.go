@@ -9,25 +9,27 @@ import (
 )
 
 func TestAccComputeSnapshot_Basic(t *testing.T) {
-	t.Skip("skipping test due to race condition during deletion in api")
-
 	volumeName := acctest.RandomWithPrefix("test-volume")
-	volumeSize := acctest.RandIntRange(1, 20)
 	snapshotName := acctest.RandomWithPrefix("test-snapshot")
 
 	resource.ParallelTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccComputeSnapshotConfigBasic, volumeName, volumeSize, snapshotName),
+				Config: fmt.Sprintf(testAccComputeSnapshotConfigBasic, volumeName, snapshotName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("flow_compute_snapshot.foobar", "id"),
 					resource.TestCheckResourceAttr("flow_compute_snapshot.foobar", "name", snapshotName),
-					resource.TestCheckResourceAttr("flow_compute_snapshot.foobar", "size", fmt.Sprint(volumeSize)),
-					resource.TestCheckResourceAttrSet("flow_compute_snapshot.foobar", "volume_id"),
-					resource.TestCheckResourceAttrSet("flow_compute_snapshot.foobar", "created_at"),
+					resource.TestCheckResourceAttrPair("flow_compute_snapshot.foobar", "volume_id", "flow_compute_volume.foobar", "id"),
+					resource.TestCheckResourceAttr("flow_compute_snapshot.foobar", "timeouts.create", "45m"),
 				),
 			},
+			{
+				ResourceName:            "flow_compute_snapshot.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
 		},
 	})
 }
@@ -37,11 +39,15 @@ resource "flow_compute_volume" "foobar" {
 	name        = "%s"
 	location_id = 1
 
-	size = %d
+	size = 10
 }
 
 resource "flow_compute_snapshot" "foobar" {
-	name        = "%s"
-	volume_id   = flow_compute_volume.foobar.id
+	name      = "%s"
+	volume_id = flow_compute_volume.foobar.id
+
+	timeouts = {
+		create = "45m"
+	}
 }
 `
@@ -3,6 +3,7 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -19,6 +20,12 @@ var (
 	_ tfsdk.ResourceWithImportState = (*computeVolumeResource)(nil)
 )
 
+var computeVolumeTimeouts = resourceTimeouts{
+	Create: 10 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 type computeVolumeResourceData struct {
 	ID           types.Int64  `tfsdk:"id"`
 	SerialNumber types.String `tfsdk:"serial_number"`
@@ -26,6 +33,8 @@ type computeVolumeResourceData struct {
 	Size         types.Int64  `tfsdk:"size"`
 	Location     types.Int64  `tfsdk:"location_id"`
 	Snapshot     types.Int64  `tfsdk:"restore_from_snapshot_id"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (d *computeVolumeResourceData) FromEntity(volume compute.Volume) {
@@ -90,6 +99,7 @@ func (t computeVolumeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema,
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"timeouts": computeVolumeTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -117,6 +127,15 @@ func (r computeVolumeResource) Create(ctx context.Context, request tfsdk.CreateR
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(computeVolumeTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := compute.VolumeCreate{
 		Name:       config.Name.Value,
 		Size:       int(config.Size.Value),
@@ -142,11 +161,17 @@ func (r computeVolumeResource) Create(ctx context.Context, request tfsdk.CreateR
 		"data": volume,
 	})
 
+	state.Timeouts = config.Timeouts
+
 	if volume.Status.ID == compute.VolumeStatusWorking {
 		// wait for the volume to be ready
-		waitForCondition(ctx, func(ctx context.Context) (bool, diag.Diagnostics) {
+		diagnostics = waitForCondition(ctx, fmt.Sprintf("volume %d to become ready", volume.ID), func(ctx context.Context) (bool, diag.Diagnostics) {
 			return r.waitForVolumeStatus(ctx, volume.ID)
 		})
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	diagnostics = response.State.Set(ctx, state)
@@ -162,6 +187,11 @@ func (r computeVolumeResource) Read(ctx context.Context, request tfsdk.ReadResou
 	}
 
 	volume, err := r.volumeService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get volume: %s", err))
 		return
@@ -188,6 +218,15 @@ func (r computeVolumeResource) Update(ctx context.Context, request tfsdk.UpdateR
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(computeVolumeTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	volume, err := r.volumeService.Get(ctx, int(state.ID.Value))
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get volume: %s", err))
@@ -228,9 +267,20 @@ func (r computeVolumeResource) Update(ctx context.Context, request tfsdk.UpdateR
 			response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to expand volume: %s", err))
 			return
 		}
+
+		if volume.Status.ID == compute.VolumeStatusWorking {
+			diagnostics = waitForCondition(ctx, fmt.Sprintf("volume %d to be expanded", volume.ID), func(ctx context.Context) (bool, diag.Diagnostics) {
+				return r.waitForVolumeStatus(ctx, volume.ID)
+			})
+			response.Diagnostics.Append(diagnostics...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 
 	state.FromEntity(volume)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -244,15 +294,30 @@ func (r computeVolumeResource) Delete(ctx context.Context, request tfsdk.DeleteR
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(computeVolumeTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	err := r.volumeService.Delete(ctx, int(state.ID.Value))
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete volume: %s", err))
 		return
 	}
+
+	diagnostics = waitForDeletion(ctx, fmt.Sprintf("volume %d", state.ID.Value), func(ctx context.Context) error {
+		_, err := r.volumeService.Get(ctx, int(state.ID.Value))
+		return err
+	})
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (r computeVolumeResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
 
 func (r computeVolumeResource) waitForVolumeStatus(ctx context.Context, volumeID int) (done bool, diagnostics diag.Diagnostics) {
@@ -7,7 +7,6 @@ import (
 	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/compute"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -120,11 +119,22 @@ func (r computeVolumeAttachmentResource) Read(ctx context.Context, request tfsdk
 	}
 
 	volume, err := compute.NewVolumeService(r.client).Get(ctx, int(state.VolumeID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get volume: %s", err))
 		return
 	}
 
+	// the volume has been detached outside of terraform
+	if volume.AttachedTo.ID == 0 {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	state.FromEntity(volume)
 
 	diagnostics = response.State.Set(ctx, state)
@@ -190,5 +200,5 @@ func (r computeVolumeAttachmentResource) Delete(ctx context.Context, request tfs
 }
 
 func (r computeVolumeAttachmentResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("volume_id"), request, response)
+	importStateFromID(ctx, request, response, "server_id", "volume_id")
 }
@@ -2,38 +2,74 @@ package flow
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/compute"
 	"github.com/flowswiss/goclient/kubernetes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/filter"
+	"github.com/flowswiss/terraform-provider-flow/validators"
 )
 
 var (
-	_ tfsdk.ResourceType            = (*kubernetesClusterResourceType)(nil)
-	_ tfsdk.Resource                = (*kubernetesClusterResource)(nil)
-	_ tfsdk.ResourceWithImportState = (*kubernetesClusterResource)(nil)
+	_ tfsdk.ResourceType                 = (*kubernetesClusterResourceType)(nil)
+	_ tfsdk.Resource                     = (*kubernetesClusterResource)(nil)
+	_ tfsdk.ResourceWithImportState      = (*kubernetesClusterResource)(nil)
+	_ tfsdk.ResourceWithConfigValidators = (*kubernetesClusterResource)(nil)
+	_ tfsdk.ResourceWithModifyPlan       = (*kubernetesClusterResource)(nil)
 )
 
+var kubernetesClusterTimeouts = resourceTimeouts{
+	Create: 60 * time.Minute,
+	Update: 60 * time.Minute,
+	Delete: 30 * time.Minute,
+}
+
+var kubernetesClusterReferences = attributeReferences{
+	locationReference("location_id", "location"),
+	productReference("node_product_id", "node_product", "kubernetes-node", false),
+}
+
+type kubernetesClusterConfigurationResourceData struct {
+	Variables types.String `tfsdk:"variables"`
+}
+
 type kubernetesClusterResourceData struct {
 	ID   types.Int64  `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 
-	LocationID      types.Int64 `tfsdk:"location_id"`
-	NetworkID       types.Int64 `tfsdk:"network_id"`
-	SecurityGroupID types.Int64 `tfsdk:"security_group_id"`
+	LocationID      types.Int64  `tfsdk:"location_id"`
+	Location        types.String `tfsdk:"location"`
+	NetworkID       types.Int64  `tfsdk:"network_id"`
+	SecurityGroupID types.Int64  `tfsdk:"security_group_id"`
 
 	Public        types.Bool   `tfsdk:"public"`
 	PublicAddress types.String `tfsdk:"public_address"`
 	DNSName       types.String `tfsdk:"dns_name"`
 
-	VersionID types.Int64 `tfsdk:"version_id"`
+	VersionID     types.Int64                                 `tfsdk:"version_id"`
+	Configuration *kubernetesClusterConfigurationResourceData `tfsdk:"configuration"`
+
+	// the worker nodes form a single group, as the worker of ClusterWorkerCreate and ClusterWorkerUpdate is the only
+	// representation of worker nodes offered by the cluster service, which has no node pools
+	NodeCount     types.Int64  `tfsdk:"node_count"`
+	NodeProductID types.Int64  `tfsdk:"node_product_id"`
+	NodeProduct   types.String `tfsdk:"node_product"`
+
+	CurrentNodeCount             types.Int64  `tfsdk:"current_node_count"`
+	CurrentControlPlaneNodeCount types.Int64  `tfsdk:"current_control_plane_node_count"`
+	Status                       types.String `tfsdk:"status"`
 
-	NodeCount     types.Int64 `tfsdk:"node_count"`
-	NodeProductID types.Int64 `tfsdk:"node_product_id"`
+	OrderID  types.Int64           `tfsdk:"order_id"`
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (k *kubernetesClusterResourceData) FromEntity(cluster kubernetes.Cluster) {
@@ -58,6 +94,35 @@ func (k *kubernetesClusterResourceData) FromEntity(cluster kubernetes.Cluster) {
 
 	k.NodeCount = types.Int64{Value: int64(cluster.NodeCount.Expected.Worker)}
 	k.NodeProductID = types.Int64{Value: int64(cluster.ExpectedPreset.Worker.ID)}
+
+	k.CurrentNodeCount = types.Int64{Value: int64(cluster.NodeCount.Current.Worker)}
+	k.CurrentControlPlaneNodeCount = types.Int64{Value: int64(cluster.NodeCount.Current.ControlPlane)}
+	k.Status = types.String{Value: cluster.Status.Key}
+}
+
+// FromConfiguration stores the variables of the cluster configuration which are managed, i.e. the top-level keys of
+// the stored variables. Any other variable, such as the defaults of the api, is ignored. The stored variables are kept
+// if they are equivalent, which preserves the formatting of the configured variables.
+func (k *kubernetesClusterResourceData) FromConfiguration(configuration kubernetes.ClusterConfiguration) error {
+	document := string(configuration.Variables)
+	if len(document) == 0 {
+		document = "{}"
+	}
+
+	variables, err := selectJSONObject(document, k.Configuration.Variables.Value)
+	if err != nil {
+		return err
+	}
+
+	if equivalentJSON(k.Configuration.Variables.Value, variables) {
+		return nil
+	}
+
+	k.Configuration = &kubernetesClusterConfigurationResourceData{
+		Variables: types.String{Value: variables},
+	}
+
+	return nil
 }
 
 type kubernetesClusterNameFilter struct {
@@ -81,6 +146,14 @@ func (k kubernetesClusterResourceType) GetSchema(ctx context.Context) (tfsdk.Sch
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"order_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the order which created the cluster",
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 			"name": {
 				Type:                types.StringType,
 				MarkdownDescription: "name of the cluster",
@@ -88,12 +161,18 @@ func (k kubernetesClusterResourceType) GetSchema(ctx context.Context) (tfsdk.Sch
 			},
 			"location_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the location",
-				Required:            true,
+				MarkdownDescription: "unique identifier of the location, conflicts with `location`",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"location": {
+				Type:                types.StringType,
+				MarkdownDescription: "key of the location (e.g. `ALP1`), conflicts with `location_id`",
+				Optional:            true,
+			},
 			"network_id": {
 				Type:                types.Int64Type,
 				MarkdownDescription: "unique identifier of the network",
@@ -112,7 +191,7 @@ func (k kubernetesClusterResourceType) GetSchema(ctx context.Context) (tfsdk.Sch
 			},
 			"public": {
 				Type:                types.BoolType,
-				MarkdownDescription: "indicates if the cluster is public",
+				MarkdownDescription: "indicates if the api of the cluster is reachable through a public address. The public address can only be assigned when the cluster is created, changing this recreates the cluster",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
@@ -137,23 +216,59 @@ func (k kubernetesClusterResourceType) GetSchema(ctx context.Context) (tfsdk.Sch
 			},
 			"version_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the kubernetes version",
+				MarkdownDescription: "unique identifier of the kubernetes version, the cluster is upgraded through every intermediate minor version if changed. The version has to be offered by the api (see `flow_kubernetes_version`), new clusters are created with the default version if omitted",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"configuration": {
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"variables": {
+						Type:                types.StringType,
+						MarkdownDescription: "JSON encoded variables of the configuration, such as the ingress, load balancer and add-on settings defined by the schema of the kubernetes version. Only the configured top-level variables are managed, any other variable retains its current value",
+						Required:            true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.JSONObject(),
+						},
+					},
+				}),
+				MarkdownDescription: "configuration of the cluster, which is only managed if configured",
+				Optional:            true,
+			},
 			"node_count": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "number of nodes in the cluster",
+				MarkdownDescription: "number of worker nodes in the cluster. The api supports a single group of worker nodes sharing the same product per cluster, separate node pools with their own product, labels or taints are not supported",
 				Required:            true,
 			},
 			"node_product_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the node product",
-				Required:            true,
+				MarkdownDescription: "unique identifier of the product of all worker nodes, conflicts with `node_product`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"node_product": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the node product (e.g. `Worker Small`), conflicts with `node_product_id`",
+				Optional:            true,
+			},
+			"current_node_count": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "number of worker nodes currently running in the cluster",
+				Computed:            true,
+			},
+			"current_control_plane_node_count": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "number of control plane nodes currently running in the cluster",
+				Computed:            true,
+			},
+			"status": {
+				Type:                types.StringType,
+				MarkdownDescription: "status of the cluster (e.g. `healthy` or `working`)",
+				Computed:            true,
 			},
+			"timeouts": kubernetesClusterTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -165,24 +280,35 @@ func (k kubernetesClusterResourceType) NewResource(ctx context.Context, p tfsdk.
 	}
 
 	return kubernetesClusterResource{
+		client:         prov.client,
 		orderService:   common.NewOrderService(prov.client),
 		clusterService: kubernetes.NewClusterService(prov.client),
 	}, diagnostics
 }
 
 type kubernetesClusterResource struct {
+	client         goclient.Client
 	orderService   common.OrderService
 	clusterService kubernetes.ClusterService
 }
 
 func (k kubernetesClusterResource) Create(ctx context.Context, request tfsdk.CreateResourceRequest, response *tfsdk.CreateResourceResponse) {
 	var config kubernetesClusterResourceData
-	diagnostics := request.Config.Get(ctx, &config)
+	diagnostics := request.Plan.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diagnostics := config.Timeouts.CreateTimeout(kubernetesClusterTimeouts)
 	response.Diagnostics.Append(diagnostics...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := kubernetes.ClusterCreate{
 		Name:       config.Name.Value,
 		LocationID: int(config.LocationID.Value),
@@ -194,7 +320,7 @@ func (k kubernetesClusterResource) Create(ctx context.Context, request tfsdk.Cre
 		AttachExternalIP: true,
 	}
 
-	if !config.Public.Null && !config.Public.Value {
+	if !config.Public.Unknown && !config.Public.Null && !config.Public.Value {
 		create.AttachExternalIP = false
 	}
 
@@ -204,9 +330,16 @@ func (k kubernetesClusterResource) Create(ctx context.Context, request tfsdk.Cre
 		return
 	}
 
-	order, err := k.orderService.WaitUntilProcessed(ctx, ordering)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("waiting for cluster creation: %s", err))
+	// keep track of the cluster in case waiting for its order fails or is interrupted
+	diagnostics = setOrderedState(ctx, request.Plan, &response.State, ordering)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	order, diagnostics := waitForOrder(ctx, k.orderService, ordering)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
@@ -216,9 +349,45 @@ func (k kubernetesClusterResource) Create(ctx context.Context, request tfsdk.Cre
 		return
 	}
 
+	// the version and the configuration can only be changed once the cluster exists
+	if !config.VersionID.Unknown && int(config.VersionID.Value) != cluster.Version.ID {
+		cluster, diagnostics = k.upgradeVersion(ctx, cluster.ID, int(config.VersionID.Value))
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if config.Configuration != nil {
+		variables, changed, diagnostics := k.updateVariables(ctx, cluster.ID, config.Configuration.Variables.Value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if changed {
+			cluster, diagnostics = k.waitForHealthy(ctx, cluster.ID, "apply the configuration", k.configurationApplied(variables))
+			response.Diagnostics.Append(diagnostics...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	// set state of the resource
 	var state kubernetesClusterResourceData
 	state.FromEntity(cluster)
+	state.Configuration = config.Configuration
+	state.Location = config.Location
+	state.NodeProduct = config.NodeProduct
+	state.OrderID = types.Int64{Value: int64(order.ID)}
+	state.Timeouts = config.Timeouts
+
+	diagnostics = k.readConfiguration(ctx, &state)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -232,7 +401,28 @@ func (k kubernetesClusterResource) Read(ctx context.Context, request tfsdk.ReadR
 		return
 	}
 
+	if state.ID.Null {
+		// the creation of the cluster was interrupted, recover its identifier from the order
+		id, processed, diagnostics := getOrderedID(ctx, k.orderService, state.OrderID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() || !processed {
+			return
+		}
+
+		if id.Null {
+			response.State.RemoveResource(ctx)
+			return
+		}
+
+		state.ID = id
+	}
+
 	cluster, err := k.clusterService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster: %s", err))
 		return
@@ -240,6 +430,12 @@ func (k kubernetesClusterResource) Read(ctx context.Context, request tfsdk.ReadR
 
 	state.FromEntity(cluster)
 
+	diagnostics = k.readConfiguration(ctx, &state)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
 }
@@ -253,12 +449,21 @@ func (k kubernetesClusterResource) Update(ctx context.Context, request tfsdk.Upd
 	}
 
 	var config kubernetesClusterResourceData
-	diagnostics = request.Config.Get(ctx, &config)
+	diagnostics = request.Plan.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(kubernetesClusterTimeouts)
 	response.Diagnostics.Append(diagnostics...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	if config.Name.Value != state.Name.Value {
 		update := kubernetes.ClusterUpdate{
 			Name: config.Name.Value,
@@ -271,17 +476,31 @@ func (k kubernetesClusterResource) Update(ctx context.Context, request tfsdk.Upd
 		}
 	}
 
+	configurationChanged := config.Configuration != nil &&
+		(state.Configuration == nil || !equivalentJSON(config.Configuration.Variables.Value, state.Configuration.Variables.Value))
+
 	if config.VersionID.Value != state.VersionID.Value {
-		update := kubernetes.ClusterConfiguration{
-			VersionID: int(config.VersionID.Value),
-			// TODO configuration options
+		_, diagnostics = k.upgradeVersion(ctx, int(state.ID.Value), int(config.VersionID.Value))
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
 		}
+	}
 
-		_, err := k.clusterService.UpdateConfiguration(ctx, int(state.ID.Value), update)
-		if err != nil {
-			response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to change cluster configuration: %s", err))
+	if configurationChanged {
+		variables, changed, diagnostics := k.updateVariables(ctx, int(state.ID.Value), config.Configuration.Variables.Value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
 			return
 		}
+
+		if changed {
+			_, diagnostics = k.waitForHealthy(ctx, int(state.ID.Value), "apply the configuration", k.configurationApplied(variables))
+			response.Diagnostics.Append(diagnostics...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 
 	if config.NodeCount.Value != state.NodeCount.Value || config.NodeProductID.Value != state.NodeProductID.Value {
@@ -297,6 +516,15 @@ func (k kubernetesClusterResource) Update(ctx context.Context, request tfsdk.Upd
 			response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to change cluster flavor: %s", err))
 			return
 		}
+
+		_, diagnostics = k.waitForHealthy(ctx, int(state.ID.Value), "change the flavor", func(ctx context.Context, cluster kubernetes.Cluster) (bool, diag.Diagnostics) {
+			return kubernetesClusterWorking(cluster) ||
+				(cluster.ExpectedPreset.Worker.ID == update.Worker.ProductID && cluster.NodeCount.Expected.Worker == update.Worker.Count), nil
+		})
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	cluster, err := k.clusterService.Get(ctx, int(state.ID.Value))
@@ -306,6 +534,16 @@ func (k kubernetesClusterResource) Update(ctx context.Context, request tfsdk.Upd
 	}
 
 	state.FromEntity(cluster)
+	state.Configuration = config.Configuration
+	state.Location = config.Location
+	state.NodeProduct = config.NodeProduct
+	state.Timeouts = config.Timeouts
+
+	diagnostics = k.readConfiguration(ctx, &state)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -319,13 +557,308 @@ func (k kubernetesClusterResource) Delete(ctx context.Context, request tfsdk.Del
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(kubernetesClusterTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if state.ID.Null {
+		// the creation of the cluster was interrupted, wait for its order to find out whether it has to be deleted
+		id, diagnostics := waitForOrderedID(ctx, k.orderService, state.OrderID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() || id.Null {
+			return
+		}
+
+		state.ID = id
+	}
+
 	err := k.clusterService.Delete(ctx, int(state.ID.Value))
 	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete router: %s", err))
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete cluster: %s", err))
 		return
 	}
+
+	diagnostics = waitForDeletion(ctx, fmt.Sprintf("cluster %d", state.ID.Value), func(ctx context.Context) error {
+		_, err := k.clusterService.Get(ctx, int(state.ID.Value))
+		return err
+	})
+	response.Diagnostics.Append(diagnostics...)
+}
+
+func (k kubernetesClusterResource) ConfigValidators(ctx context.Context) []tfsdk.ResourceConfigValidator {
+	return kubernetesClusterReferences.ConfigValidators()
+}
+
+func (k kubernetesClusterResource) ModifyPlan(ctx context.Context, request tfsdk.ModifyResourcePlanRequest, response *tfsdk.ModifyResourcePlanResponse) {
+	kubernetesClusterReferences.ModifyPlan(ctx, k.client, request, response)
+	if response.Diagnostics.HasError() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// the public address can not be attached to or detached from an existing cluster, which is replaced instead
+	if !request.State.Raw.IsNull() {
+		var currentPublic, plannedPublic types.Bool
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("public"), &currentPublic)...)
+		response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("public"), &plannedPublic)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !plannedPublic.Unknown && !plannedPublic.Equal(currentPublic) {
+			response.Diagnostics.AddAttributeWarning(
+				path.Root("public"),
+				"Cluster Replacement",
+				"Changing the public access of an existing cluster destroys the cluster and creates a new one, including all of its nodes, workloads and data, as the api can not attach or detach the public address of a cluster.",
+			)
+		}
+	}
+
+	var planned types.Int64
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("version_id"), &planned)...)
+	if response.Diagnostics.HasError() || planned.Unknown || planned.Null {
+		return
+	}
+
+	// new clusters are created with the default version, which is not exposed by the api
+	current := types.Int64{Null: true}
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("version_id"), &current)...)
+		if response.Diagnostics.HasError() || current.Value == planned.Value {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(k.validateVersion(ctx, current, int(planned.Value))...)
 }
 
 func (k kubernetesClusterResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
+}
+
+// readConfiguration refreshes the configuration of the cluster in the state, as long as it is managed by terraform.
+func (k kubernetesClusterResource) readConfiguration(ctx context.Context, state *kubernetesClusterResourceData) (diagnostics diag.Diagnostics) {
+	if state.Configuration == nil {
+		return
+	}
+
+	configuration, err := k.clusterService.GetConfiguration(ctx, int(state.ID.Value))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster configuration: %s", err))
+		return
+	}
+
+	err = state.FromConfiguration(configuration)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to parse cluster configuration: %s", err))
+	}
+
+	return
+}
+
+// updateVariables replaces the configured top-level variables of the cluster configuration. The current configuration is
+// used as a starting point to retain the version and the variables which are not managed. The configuration is only
+// updated if this changes any variable, as the cluster does not start working otherwise. The resulting variables are
+// returned to allow waiting for them to be applied.
+func (k kubernetesClusterResource) updateVariables(ctx context.Context, clusterID int, variables string) (merged string, changed bool, diagnostics diag.Diagnostics) {
+	update, err := k.clusterService.GetConfiguration(ctx, clusterID)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster configuration: %s", err))
+		return
+	}
+
+	current := string(update.Variables)
+	if len(current) == 0 {
+		current = "{}"
+	}
+
+	merged, err = mergeJSONObjects(current, variables)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to merge cluster configuration: %s", err))
+		return
+	}
+
+	if equivalentJSON(current, merged) {
+		return
+	}
+
+	update.Variables = json.RawMessage(merged)
+
+	_, err = k.clusterService.UpdateConfiguration(ctx, clusterID, update)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to change cluster configuration: %s", err))
+		return
+	}
+
+	changed = true
+	return
+}
+
+// upgradeVersion upgrades the cluster to the target version. As minor versions can not be skipped, the cluster is
+// upgraded through every intermediate minor version, waiting for it to become healthy after each step.
+func (k kubernetesClusterResource) upgradeVersion(ctx context.Context, clusterID int, targetID int) (cluster kubernetes.Cluster, diagnostics diag.Diagnostics) {
+	versions, err := listKubernetesVersions(ctx, k.client)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to list kubernetes versions: %s", err))
+		return
+	}
+
+	target, err := filter.FindOne(kubernetesVersionDataSourceData{
+		ID:      types.Int64{Value: int64(targetID)},
+		Version: types.String{Null: true},
+	}, versions)
+	if err != nil {
+		diagnostics.AddError("Not Found", fmt.Sprintf("unable to find kubernetes version %d: %s", targetID, err))
+		return
+	}
+
+	for {
+		cluster, err = k.clusterService.Get(ctx, clusterID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster: %s", err))
+			return
+		}
+
+		if cluster.Version.ID == target.ID {
+			return
+		}
+
+		next, ok := nextKubernetesVersion(cluster.Version, target)
+		if !ok {
+			diagnostics.AddError("Invalid Version", fmt.Sprintf("cluster %d can not be upgraded from version %s to version %s", clusterID, cluster.Version.Name, target.Name))
+			return
+		}
+
+		// start from the current configuration to retain the variables
+		update, err := k.clusterService.GetConfiguration(ctx, clusterID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster configuration: %s", err))
+			return
+		}
+
+		update.VersionID = next.ID
+
+		_, err = k.clusterService.UpdateConfiguration(ctx, clusterID, update)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to upgrade cluster to version %s: %s", next.Name, err))
+			return
+		}
+
+		// the next upgrade is rejected until the cluster runs the version of the previous one
+		_, diagnostics = k.waitForHealthy(ctx, clusterID, fmt.Sprintf("upgrade to version %s", next.Name), func(ctx context.Context, cluster kubernetes.Cluster) (bool, diag.Diagnostics) {
+			return cluster.Version.ID == next.ID, nil
+		})
+		if diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// validateVersion ensures that the cluster can be upgraded from the current to the target version before anything is
+// ordered or changed. The target has to be offered by the api, as upgradeVersion can not find it otherwise, and it must
+// not be older than the current version, as clusters can not be downgraded.
+func (k kubernetesClusterResource) validateVersion(ctx context.Context, currentID types.Int64, targetID int) (diagnostics diag.Diagnostics) {
+	versions, err := listKubernetesVersions(ctx, k.client)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to list kubernetes versions: %s", err))
+		return
+	}
+
+	// nothing can be validated without known versions, the api still rejects an invalid version when it is applied
+	if len(versions) == 0 {
+		return
+	}
+
+	target, err := filter.FindOne(kubernetesVersionDataSourceData{
+		ID:      types.Int64{Value: int64(targetID)},
+		Version: types.String{Null: true},
+	}, versions)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("version_id"),
+			"Unknown Version",
+			fmt.Sprintf("kubernetes version %d is not offered by the api", targetID),
+		)
+		return
+	}
+
+	if currentID.Null {
+		return
+	}
+
+	current, err := filter.FindOne(kubernetesVersionDataSourceData{
+		ID:      currentID,
+		Version: types.String{Null: true},
+	}, versions)
+	if err != nil {
+		// the cluster has vanished, which is handled once it is refreshed
+		return
+	}
+
+	if compareKubernetesVersions(target, current) < 0 {
+		diagnostics.AddAttributeError(
+			path.Root("version_id"),
+			"Unsupported Version Change",
+			fmt.Sprintf("The cluster can not be downgraded from version %s to version %s. Please recreate the cluster instead.", current.Name, target.Name),
+		)
+	}
+
+	return
+}
+
+// waitForHealthy waits until the cluster has completed the given operation and reports to be healthy again, with all of
+// its expected nodes running. As the cluster is still healthy until the platform picks up the change, the cluster has
+// to be registered as changed first, which is observed once the registered function returns true.
+func (k kubernetesClusterResource) waitForHealthy(ctx context.Context, clusterID int, operation string, registered func(ctx context.Context, cluster kubernetes.Cluster) (bool, diag.Diagnostics)) (cluster kubernetes.Cluster, diagnostics diag.Diagnostics) {
+	changed := false
+
+	diagnostics = waitForCondition(ctx, fmt.Sprintf("cluster %d to %s", clusterID, operation), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		var err error
+		cluster, err = k.clusterService.Get(ctx, clusterID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster: %s", err))
+			return
+		}
+
+		if !changed {
+			changed, diagnostics = registered(ctx, cluster)
+			if diagnostics.HasError() {
+				return
+			}
+		}
+
+		done = changed && cluster.Status.ID == compute.ClusterStatusHealthy && cluster.NodeCount.Current == cluster.NodeCount.Expected
+		return
+	})
+
+	return
+}
+
+// kubernetesClusterWorking reports whether the cluster has left the healthy status to apply a change.
+func kubernetesClusterWorking(cluster kubernetes.Cluster) bool {
+	return cluster.Status.ID != compute.ClusterStatusHealthy
+}
+
+// configurationApplied reports whether the cluster has registered the change of its configuration to the given
+// variables. Changes which are picked up between two polls do not necessarily show up in the status of the cluster,
+// therefore the configuration read back from the api is compared as well.
+func (k kubernetesClusterResource) configurationApplied(variables string) func(ctx context.Context, cluster kubernetes.Cluster) (bool, diag.Diagnostics) {
+	return func(ctx context.Context, cluster kubernetes.Cluster) (applied bool, diagnostics diag.Diagnostics) {
+		if kubernetesClusterWorking(cluster) {
+			return true, nil
+		}
+
+		configuration, err := k.clusterService.GetConfiguration(ctx, cluster.ID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get cluster configuration: %s", err))
+			return
+		}
+
+		applied = equivalentJSON(string(configuration.Variables), variables)
+		return
+	}
 }
@@ -1,11 +1,24 @@
 package flow
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
 	"testing"
 
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/common"
+	"github.com/flowswiss/goclient/compute"
+	"github.com/flowswiss/goclient/kubernetes"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKubernetesCluster_Basic(t *testing.T) {
@@ -29,6 +42,9 @@ func TestAccKubernetesCluster_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("flow_kubernetes_cluster.foobar", "version_id"),
 					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "node_count", "3"),
 					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "node_product_id", "44"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "current_node_count", "3"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "current_control_plane_node_count", "3"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "status", "healthy"),
 				),
 			},
 		},
@@ -52,3 +68,337 @@ resource "flow_kubernetes_cluster" "foobar" {
 	node_product_id = 44
 }
 `
+
+func TestAccKubernetesCluster_References(t *testing.T) {
+	networkName := "default"
+	clusterName := acctest.RandomWithPrefix("test-cluster")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterConfigReferences, networkName, clusterName, "Worker Small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "location", "ALP1"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "location_id", "1"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "node_product", "Worker Small"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "node_product_id", "44"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterConfigReferences, networkName, clusterName, "Worker Large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "node_product", "Worker Large"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "node_product_id", "45"),
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "status", "healthy"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccKubernetesClusterConfigReferences, networkName, clusterName, "Unknown Worker"),
+				ExpectError: regexp.MustCompile(`unable to find product with name "Unknown Worker"`),
+			},
+		},
+	})
+}
+
+const testAccKubernetesClusterConfigReferences = `
+data "flow_compute_network" "foobar" {
+	name = "%s"
+}
+
+resource "flow_kubernetes_cluster" "foobar" {
+	name = "%s"
+
+	location   = "ALP1"
+	network_id = data.flow_compute_network.foobar.id
+
+	node_count   = 3
+	node_product = "%s"
+}
+`
+
+func TestAccKubernetesCluster_Configuration(t *testing.T) {
+	networkName := "default"
+	clusterName := acctest.RandomWithPrefix("test-cluster")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterConfigConfiguration, networkName, clusterName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "configuration.variables", `{"ingress":{"enabled":true}}`),
+					testAccCheckKubernetesClusterVariable("flow_kubernetes_cluster.foobar", "load_balancer"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterConfigConfiguration, networkName, clusterName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_kubernetes_cluster.foobar", "configuration.variables", `{"ingress":{"enabled":false}}`),
+					testAccCheckKubernetesClusterVariable("flow_kubernetes_cluster.foobar", "load_balancer"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKubernetesClusterVariable checks that the configuration of the cluster contains the variable, even if it
+// is not managed by terraform.
+func testAccCheckKubernetesClusterVariable(name string, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("invalid id of resource %s: %w", name, err)
+		}
+
+		configuration, err := kubernetes.NewClusterService(newTestAccClient()).GetConfiguration(context.Background(), id)
+		if err != nil {
+			return err
+		}
+
+		var variables map[string]json.RawMessage
+		if err = json.Unmarshal(configuration.Variables, &variables); err != nil {
+			return err
+		}
+
+		if _, ok = variables[key]; !ok {
+			return fmt.Errorf("variable %s of cluster %d was removed", key, id)
+		}
+
+		return nil
+	}
+}
+
+const testAccKubernetesClusterConfigConfiguration = `
+data "flow_compute_network" "foobar" {
+	name = "%s"
+}
+
+resource "flow_kubernetes_cluster" "foobar" {
+	name = "%s"
+
+	location   = "ALP1"
+	network_id = data.flow_compute_network.foobar.id
+
+	node_count   = 3
+	node_product = "Worker Small"
+
+	configuration = {
+		variables = jsonencode({
+			ingress = {
+				enabled = %t
+			}
+		})
+	}
+}
+`
+
+func TestAccKubernetesCluster_Version(t *testing.T) {
+	networkName := "default"
+	clusterName := acctest.RandomWithPrefix("test-cluster")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterConfigVersion, networkName, "1.24", clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_kubernetes_version.foobar", "name", "1.24.4"),
+					resource.TestCheckResourceAttrPair("flow_kubernetes_cluster.foobar", "version_id", "data.flow_kubernetes_version.foobar", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccKubernetesClusterConfigVersion, networkName, "latest", clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.flow_kubernetes_version.foobar", "name", "1.25.0"),
+					resource.TestCheckResourceAttr("data.flow_kubernetes_version.foobar", "major", "1"),
+					resource.TestCheckResourceAttr("data.flow_kubernetes_version.foobar", "minor", "25"),
+					resource.TestCheckResourceAttrPair("flow_kubernetes_cluster.foobar", "version_id", "data.flow_kubernetes_version.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKubernetesClusterConfigVersion = `
+data "flow_compute_network" "foobar" {
+	name = "%s"
+}
+
+data "flow_kubernetes_version" "foobar" {
+	version = "%s"
+}
+
+resource "flow_kubernetes_cluster" "foobar" {
+	name = "%s"
+
+	location   = "ALP1"
+	network_id = data.flow_compute_network.foobar.id
+
+	version_id = data.flow_kubernetes_version.foobar.id
+
+	node_count   = 3
+	node_product = "Worker Small"
+}
+`
+
+func TestKubernetesClusterResource_ValidateVersion(t *testing.T) {
+	ctx := context.Background()
+
+	// the version of the first cluster of an account can not be validated if the api does not know any versions
+	api := newFakeAPI(fakeAPIToken)
+	api.kubernetesVersions = []kubernetes.ClusterVersion{}
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	empty := goclient.NewClient(goclient.WithToken(fakeAPIToken), goclient.WithBase(server.URL+"/"))
+	if diagnostics := (kubernetesClusterResource{client: empty}).validateVersion(ctx, types.Int64{Null: true}, 2); diagnostics.HasError() {
+		t.Errorf("expected no validation without known versions, got %v", diagnostics)
+	}
+
+	// the fake api offers the versions 1.23.10, 1.24.4 and 1.25.0
+	clusters := kubernetesClusterResource{client: newFakeAPIClient(t, fakeAPIToken)}
+
+	tests := []struct {
+		name    string
+		current types.Int64
+		target  int
+		valid   bool
+	}{
+		{name: "new cluster", current: types.Int64{Null: true}, target: 3, valid: true},
+		{name: "upgrade", current: types.Int64{Value: 1}, target: 3, valid: true},
+		{name: "downgrade", current: types.Int64{Value: 2}, target: 1, valid: false},
+		{name: "unknown version", current: types.Int64{Value: 1}, target: 42, valid: false},
+		{name: "unknown version of new cluster", current: types.Int64{Null: true}, target: 42, valid: false},
+	}
+
+	for _, test := range tests {
+		diagnostics := clusters.validateVersion(ctx, test.current, test.target)
+		if diagnostics.HasError() == test.valid {
+			t.Errorf("validateVersion(%s) reported %v, expected valid %t", test.name, diagnostics, test.valid)
+		}
+	}
+}
+
+func TestKubernetesClusterResource_UpdateVariables(t *testing.T) {
+	ctx := context.Background()
+
+	client := newFakeAPIClient(t, fakeAPIToken)
+	clusters := kubernetesClusterResource{clusterService: kubernetes.NewClusterService(client)}
+	clusterID := createTestKubernetesCluster(t, client)
+
+	// the fake api defaults the proxy protocol of the load balancer to false
+	_, changed, diagnostics := clusters.updateVariables(ctx, clusterID, `{"load_balancer":{"proxy_protocol":false}}`)
+	if diagnostics.HasError() || changed {
+		t.Fatalf("expected unchanged configuration, got changed %t and %v", changed, diagnostics)
+	}
+
+	// an unchanged configuration must not keep the cluster busy, which would reject the following change
+	variables, changed, diagnostics := clusters.updateVariables(ctx, clusterID, `{"ingress":{"enabled":true}}`)
+	if diagnostics.HasError() || !changed {
+		t.Fatalf("expected changed configuration, got changed %t and %v", changed, diagnostics)
+	}
+
+	cluster, err := clusters.clusterService.Get(ctx, clusterID)
+	if err != nil {
+		t.Fatalf("unable to get cluster: %s", err)
+	}
+
+	// the configuration is registered even if the cluster has not left the healthy status
+	applied, diagnostics := clusters.configurationApplied(variables)(ctx, cluster)
+	if diagnostics.HasError() || !applied {
+		t.Errorf("expected applied configuration, got applied %t and %v", applied, diagnostics)
+	}
+}
+
+// createTestKubernetesCluster creates a cluster with a single worker through the client and returns its identifier once
+// it has been provisioned.
+func createTestKubernetesCluster(t *testing.T, client goclient.Client) int {
+	ctx := context.Background()
+
+	networks, err := compute.NewNetworkService(client).List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil || len(networks.Items) == 0 {
+		t.Fatalf("unable to list networks: %v", err)
+	}
+
+	ordering, err := kubernetes.NewClusterService(client).Create(ctx, kubernetes.ClusterCreate{
+		Name:       acctest.RandomWithPrefix("test-cluster"),
+		LocationID: 1,
+		NetworkID:  networks.Items[0].ID,
+		Worker:     kubernetes.ClusterWorkerCreate{ProductID: 44, Count: 1},
+	})
+	if err != nil {
+		t.Fatalf("unable to create cluster: %s", err)
+	}
+
+	order, diagnostics := waitForOrder(ctx, common.NewOrderService(client), ordering)
+	if diagnostics.HasError() {
+		t.Fatalf("unable to wait for cluster: %v", diagnostics)
+	}
+
+	return order.Product.ID
+}
+
+func TestKubernetesClusterResource_ModifyPlanPublic(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diagnostics := kubernetesClusterResourceType{}.GetSchema(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("unable to get schema: %v", diagnostics)
+	}
+
+	cluster := func(public bool) kubernetesClusterResourceData {
+		return kubernetesClusterResourceData{
+			ID:                           types.Int64{Value: 1},
+			Name:                         types.String{Value: "test-cluster"},
+			LocationID:                   types.Int64{Value: 1},
+			Location:                     types.String{Null: true},
+			NetworkID:                    types.Int64{Value: 1},
+			SecurityGroupID:              types.Int64{Value: 1},
+			Public:                       types.Bool{Value: public},
+			PublicAddress:                types.String{Null: true},
+			DNSName:                      types.String{Null: true},
+			VersionID:                    types.Int64{Null: true},
+			NodeCount:                    types.Int64{Value: 3},
+			NodeProductID:                types.Int64{Value: 44},
+			NodeProduct:                  types.String{Null: true},
+			CurrentNodeCount:             types.Int64{Value: 3},
+			CurrentControlPlaneNodeCount: types.Int64{Value: 1},
+			Status:                       types.String{Value: "healthy"},
+			OrderID:                      types.Int64{Null: true},
+		}
+	}
+
+	for _, changed := range []bool{false, true} {
+		state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}
+		plan := tfsdk.Plan{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}
+
+		diagnostics.Append(state.Set(ctx, cluster(true))...)
+		diagnostics.Append(plan.Set(ctx, cluster(!changed))...)
+		if diagnostics.HasError() {
+			t.Fatalf("unable to set state and plan: %v", diagnostics)
+		}
+
+		request := tfsdk.ModifyResourcePlanRequest{
+			Config: tfsdk.Config{Schema: schema, Raw: plan.Raw},
+			Plan:   plan,
+			State:  state,
+		}
+		response := tfsdk.ModifyResourcePlanResponse{Plan: plan}
+
+		kubernetesClusterResource{}.ModifyPlan(ctx, request, &response)
+		if response.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+		}
+
+		if warned := response.Diagnostics.WarningsCount() > 0; warned != changed {
+			t.Errorf("expected warning %t for changed public access %t, got %v", changed, changed, response.Diagnostics)
+		}
+	}
+}
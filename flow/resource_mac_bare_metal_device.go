@@ -3,29 +3,65 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/flowswiss/goclient"
 	"github.com/flowswiss/goclient/common"
 	"github.com/flowswiss/goclient/macbaremetal"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/flowswiss/terraform-provider-flow/validators"
 )
 
 var (
-	_ tfsdk.ResourceType            = (*macBareMetalDeviceResourceType)(nil)
-	_ tfsdk.Resource                = (*macBareMetalDeviceResource)(nil)
-	_ tfsdk.ResourceWithImportState = (*macBareMetalDeviceResource)(nil)
+	_ tfsdk.ResourceType                 = (*macBareMetalDeviceResourceType)(nil)
+	_ tfsdk.Resource                     = (*macBareMetalDeviceResource)(nil)
+	_ tfsdk.ResourceWithImportState      = (*macBareMetalDeviceResource)(nil)
+	_ tfsdk.ResourceWithConfigValidators = (*macBareMetalDeviceResource)(nil)
+	_ tfsdk.ResourceWithModifyPlan       = (*macBareMetalDeviceResource)(nil)
+)
+
+var macBareMetalDeviceTimeouts = resourceTimeouts{
+	Create: 60 * time.Minute,
+	Update: 30 * time.Minute,
+	Delete: 30 * time.Minute,
+}
+
+const (
+	macBareMetalDevicePowerStateRunning = "running"
+	macBareMetalDevicePowerStateStopped = "stopped"
 )
 
+const (
+	macBareMetalDeviceStatusRunning = "running"
+	macBareMetalDeviceStatusStopped = "stopped"
+	macBareMetalDeviceStatusError   = "error"
+)
+
+var macBareMetalDeviceReferences = attributeReferences{
+	locationReference("location_id", "location"),
+	productReference("product_id", "product", "bare-metal-device", true),
+}
+
 type macBareMetalDeviceResourceData struct {
 	ID                 types.Int64  `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
 	LocationID         types.Int64  `tfsdk:"location_id"`
+	Location           types.String `tfsdk:"location"`
 	ProductID          types.Int64  `tfsdk:"product_id"`
+	Product            types.String `tfsdk:"product"`
 	NetworkID          types.Int64  `tfsdk:"network_id"`
 	NetworkInterfaceID types.Int64  `tfsdk:"network_interface_id"`
 	Password           types.String `tfsdk:"password"`
+
+	PowerState       types.String `tfsdk:"power_state"`
+	ReinstallTrigger types.String `tfsdk:"reinstall_trigger"`
+
+	OrderID  types.Int64           `tfsdk:"order_id"`
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (m *macBareMetalDeviceResourceData) FromEntity(device macbaremetal.Device) {
@@ -34,12 +70,26 @@ func (m *macBareMetalDeviceResourceData) FromEntity(device macbaremetal.Device)
 	m.LocationID = types.Int64{Value: int64(device.Location.ID)}
 	m.ProductID = types.Int64{Value: int64(device.Product.ID)}
 	m.NetworkID = types.Int64{Value: int64(device.Network.ID)}
+	m.PowerState = types.String{Value: macBareMetalDevicePowerState(device.Status)}
 
 	if len(device.NetworkInterfaces) > 0 {
 		m.NetworkInterfaceID = types.Int64{Value: int64(device.NetworkInterfaces[0].ID)}
 	}
 }
 
+// macBareMetalDevicePowerState converts the status of a device into its power state. Transitional statuses are
+// reported by their key, which causes a difference to the configured power state.
+func macBareMetalDevicePowerState(status macbaremetal.DeviceStatus) string {
+	switch status.Key {
+	case macBareMetalDeviceStatusRunning:
+		return macBareMetalDevicePowerStateRunning
+	case macBareMetalDeviceStatusStopped:
+		return macBareMetalDevicePowerStateStopped
+	default:
+		return status.Key
+	}
+}
+
 type macBareMetalDeviceResourceType struct{}
 
 func (m macBareMetalDeviceResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -53,6 +103,14 @@ func (m macBareMetalDeviceResourceType) GetSchema(ctx context.Context) (tfsdk.Sc
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"order_id": {
+				Type:                types.Int64Type,
+				MarkdownDescription: "unique identifier of the order which created the device",
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 			"name": {
 				Type:                types.StringType,
 				MarkdownDescription: "name of the device",
@@ -60,12 +118,18 @@ func (m macBareMetalDeviceResourceType) GetSchema(ctx context.Context) (tfsdk.Sc
 			},
 			"location_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the location",
-				Required:            true,
+				MarkdownDescription: "unique identifier of the location, conflicts with `location`",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"location": {
+				Type:                types.StringType,
+				MarkdownDescription: "key of the location (e.g. `ZRH1`), conflicts with `location_id`",
+				Optional:            true,
+			},
 			"network_id": {
 				Type:                types.Int64Type,
 				MarkdownDescription: "unique identifier of the network",
@@ -85,21 +149,45 @@ func (m macBareMetalDeviceResourceType) GetSchema(ctx context.Context) (tfsdk.Sc
 			},
 			"product_id": {
 				Type:                types.Int64Type,
-				MarkdownDescription: "unique identifier of the product",
-				Required:            true,
+				MarkdownDescription: "unique identifier of the product, conflicts with `product`",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"product": {
+				Type:                types.StringType,
+				MarkdownDescription: "name of the product (e.g. `Mac mini M1`), conflicts with `product_id`",
+				Optional:            true,
+			},
 			"password": {
 				Type:                types.StringType,
-				MarkdownDescription: "password of the device",
+				MarkdownDescription: "password of the device, changing it recreates the device. The reset password workflow of the api can not be given a new password, which is why the password can not be rotated in place",
 				Required:            true,
 				Sensitive:           true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"power_state": {
+				Type:                types.StringType,
+				MarkdownDescription: "power state of the device (`running` or `stopped`)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.OneOf(macBareMetalDevicePowerStateRunning, macBareMetalDevicePowerStateStopped),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"reinstall_trigger": {
+				Type:                types.StringType,
+				MarkdownDescription: "arbitrary value which causes the device to be wiped and macOS to be reinstalled when changed. Setting the first value, e.g. on an existing or imported device, only arms the trigger without reinstalling the device",
+				Optional:            true,
+			},
+			"timeouts": macBareMetalDeviceTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -111,24 +199,35 @@ func (m macBareMetalDeviceResourceType) NewResource(ctx context.Context, p tfsdk
 	}
 
 	return macBareMetalDeviceResource{
+		client:        prov.client,
 		orderService:  common.NewOrderService(prov.client),
 		deviceService: macbaremetal.NewDeviceService(prov.client),
 	}, diagnostics
 }
 
 type macBareMetalDeviceResource struct {
+	client        goclient.Client
 	orderService  common.OrderService
 	deviceService macbaremetal.DeviceService
 }
 
 func (m macBareMetalDeviceResource) Create(ctx context.Context, request tfsdk.CreateResourceRequest, response *tfsdk.CreateResourceResponse) {
 	var config macBareMetalDeviceResourceData
-	diagnostics := request.Config.Get(ctx, &config)
+	diagnostics := request.Plan.Get(ctx, &config)
 	response.Diagnostics.Append(diagnostics...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(macBareMetalDeviceTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := macbaremetal.DeviceCreate{
 		Name:            config.Name.Value,
 		LocationID:      int(config.LocationID.Value),
@@ -144,9 +243,16 @@ func (m macBareMetalDeviceResource) Create(ctx context.Context, request tfsdk.Cr
 		return
 	}
 
-	order, err := m.orderService.WaitUntilProcessed(ctx, ordering)
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("waiting for device creation: %s", err))
+	// keep track of the device in case waiting for its order fails or is interrupted
+	diagnostics = setOrderedState(ctx, request.Plan, &response.State, ordering)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	order, diagnostics := waitForOrder(ctx, m.orderService, ordering)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
@@ -156,10 +262,30 @@ func (m macBareMetalDeviceResource) Create(ctx context.Context, request tfsdk.Cr
 		return
 	}
 
+	// the power state is read from the configuration, where it is null instead of unknown if it is not configured
+	var powerState types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("power_state"), &powerState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !powerState.Null && !powerState.Unknown && powerState.Value != macBareMetalDevicePowerState(device.Status) {
+		device, diagnostics = m.changePowerState(ctx, device.ID, powerState.Value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var state macBareMetalDeviceResourceData
 	state.FromEntity(device)
 
+	state.Location = config.Location
+	state.Product = config.Product
 	state.Password = config.Password
+	state.ReinstallTrigger = config.ReinstallTrigger
+	state.OrderID = types.Int64{Value: int64(order.ID)}
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -173,7 +299,28 @@ func (m macBareMetalDeviceResource) Read(ctx context.Context, request tfsdk.Read
 		return
 	}
 
+	if state.ID.Null {
+		// the creation of the device was interrupted, recover its identifier from the order
+		id, processed, diagnostics := getOrderedID(ctx, m.orderService, state.OrderID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() || !processed {
+			return
+		}
+
+		if id.Null {
+			response.State.RemoveResource(ctx)
+			return
+		}
+
+		state.ID = id
+	}
+
 	device, err := m.deviceService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get device: %s", err))
 		return
@@ -194,12 +341,21 @@ func (m macBareMetalDeviceResource) Update(ctx context.Context, request tfsdk.Up
 	}
 
 	var config macBareMetalDeviceResourceData
-	diagnostics = request.Config.Get(ctx, &config)
+	diagnostics = request.Plan.Get(ctx, &config)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(macBareMetalDeviceTimeouts)
 	response.Diagnostics.Append(diagnostics...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	update := macbaremetal.DeviceUpdate{
 		Name: config.Name.Value,
 	}
@@ -210,7 +366,36 @@ func (m macBareMetalDeviceResource) Update(ctx context.Context, request tfsdk.Up
 		return
 	}
 
+	// the first value only arms the trigger, so adding it to an existing device does not wipe the device
+	reinstallTriggered := !state.ReinstallTrigger.Null && !config.ReinstallTrigger.Null && !config.ReinstallTrigger.Equal(state.ReinstallTrigger)
+	if reinstallTriggered {
+		device, diagnostics = m.runWorkflow(ctx, device.ID, "reinstall", macBareMetalDeviceStatusRunning)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the plan carries the prior power state if it is not configured, which may be a transitional status
+	var powerState types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("power_state"), &powerState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !powerState.Null && !powerState.Unknown && powerState.Value != macBareMetalDevicePowerState(device.Status) {
+		device, diagnostics = m.changePowerState(ctx, device.ID, powerState.Value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	state.FromEntity(device)
+	state.Location = config.Location
+	state.Product = config.Product
+	state.ReinstallTrigger = config.ReinstallTrigger
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -224,13 +409,155 @@ func (m macBareMetalDeviceResource) Delete(ctx context.Context, request tfsdk.De
 		return
 	}
 
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(macBareMetalDeviceTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if state.ID.Null {
+		// the creation of the device was interrupted, wait for its order to find out whether it has to be deleted
+		id, diagnostics := waitForOrderedID(ctx, m.orderService, state.OrderID)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() || id.Null {
+			return
+		}
+
+		state.ID = id
+	}
+
 	err := m.deviceService.Delete(ctx, int(state.ID.Value))
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete device: %s", err))
 		return
 	}
+
+	diagnostics = waitForDeletion(ctx, fmt.Sprintf("device %d", state.ID.Value), func(ctx context.Context) error {
+		_, err := m.deviceService.Get(ctx, int(state.ID.Value))
+		return err
+	})
+	response.Diagnostics.Append(diagnostics...)
+}
+
+func (m macBareMetalDeviceResource) ConfigValidators(ctx context.Context) []tfsdk.ResourceConfigValidator {
+	return macBareMetalDeviceReferences.ConfigValidators()
+}
+
+func (m macBareMetalDeviceResource) ModifyPlan(ctx context.Context, request tfsdk.ModifyResourcePlanRequest, response *tfsdk.ModifyResourcePlanResponse) {
+	macBareMetalDeviceReferences.ModifyPlan(ctx, m.client, request, response)
+	if response.Diagnostics.HasError() || request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// an unconfigured power state carries the prior status, which may be transitional and change until it is applied
+	var powerState types.String
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("power_state"), &powerState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !powerState.Unknown && powerState.Value != macBareMetalDevicePowerStateRunning && powerState.Value != macBareMetalDevicePowerStateStopped {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("power_state"), types.String{Unknown: true})...)
+	}
 }
 
 func (m macBareMetalDeviceResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
+}
+
+// changePowerState starts or stops the device depending on the requested power state.
+func (m macBareMetalDeviceResource) changePowerState(ctx context.Context, deviceID int, powerState string) (macbaremetal.Device, diag.Diagnostics) {
+	switch powerState {
+	case macBareMetalDevicePowerStateRunning:
+		return m.runAction(ctx, deviceID, "start", macBareMetalDeviceStatusRunning)
+	case macBareMetalDevicePowerStateStopped:
+		return m.runAction(ctx, deviceID, "stop", macBareMetalDeviceStatusStopped)
+	}
+
+	var diagnostics diag.Diagnostics
+	diagnostics.AddError("Invalid Power State", fmt.Sprintf("unable to change the power state of device %d to %q", deviceID, powerState))
+	return macbaremetal.Device{}, diagnostics
+}
+
+// runAction runs the action on the device and waits until the device has reached the given status.
+func (m macBareMetalDeviceResource) runAction(ctx context.Context, deviceID int, action string, status string) (macbaremetal.Device, diag.Diagnostics) {
+	_, err := macbaremetal.NewDeviceActionService(m.client, deviceID).Run(ctx, macbaremetal.DeviceRunAction{Action: action})
+	if err != nil {
+		var diagnostics diag.Diagnostics
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to %s device: %s", action, err))
+		return macbaremetal.Device{}, diagnostics
+	}
+
+	return m.waitForStatus(ctx, deviceID, action, status)
+}
+
+// runWorkflow runs the workflow on the device and waits until the device has reached the given status again. Workflows
+// are only offered for some devices, which is why the availability of the workflow is checked first.
+func (m macBareMetalDeviceResource) runWorkflow(ctx context.Context, deviceID int, workflow string, status string) (device macbaremetal.Device, diagnostics diag.Diagnostics) {
+	workflowService := macbaremetal.NewDeviceWorkflowService(m.client, deviceID)
+
+	list, err := workflowService.List(ctx, goclient.Cursor{NoFilter: 1})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to list device workflows: %s", err))
+		return
+	}
+
+	available := false
+	for _, candidate := range list.Items {
+		available = available || candidate.Command == workflow
+	}
+
+	if !available {
+		diagnostics.AddError("Not Supported", fmt.Sprintf("workflow %q is not available for device %d", workflow, deviceID))
+		return
+	}
+
+	_, err = workflowService.Run(ctx, macbaremetal.DeviceRunWorkflow{Workflow: workflow})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("unable to %s device: %s", workflow, err))
+		return
+	}
+
+	// the device usually has the status the workflow ends in already, so the workflow has to begin before waiting for
+	// the status. A workflow which begins and ends between two polls is not observed, hence the grace period.
+	diagnostics = waitForTransition(ctx, fmt.Sprintf("device %d to begin the %s", deviceID, workflow), transitionGracePeriod, func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		device, err := m.deviceService.Get(ctx, deviceID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get device: %s", err))
+			return
+		}
+
+		return device.Status.Key != status, nil
+	})
+	if diagnostics.HasError() {
+		return
+	}
+
+	return m.waitForStatus(ctx, deviceID, workflow, status)
+}
+
+// waitForStatus waits until the device has reached the given status, failing as soon as the device reports an error.
+// The operation describes what the device is doing in the meantime and is included in the diagnostics.
+func (m macBareMetalDeviceResource) waitForStatus(ctx context.Context, deviceID int, operation string, status string) (device macbaremetal.Device, diagnostics diag.Diagnostics) {
+	diagnostics = waitForCondition(ctx, fmt.Sprintf("device %d to %s", deviceID, operation), func(ctx context.Context) (done bool, diagnostics diag.Diagnostics) {
+		var err error
+		device, err = m.deviceService.Get(ctx, deviceID)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("unable to get device: %s", err))
+			return
+		}
+
+		if device.Status.Key == macBareMetalDeviceStatusError {
+			diagnostics.AddError("Device Error", fmt.Sprintf("device %d failed to %s", deviceID, operation))
+			return
+		}
+
+		done = device.Status.Key == status
+		return
+	})
+
+	return
 }
@@ -0,0 +1,249 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/flowswiss/goclient"
+	"github.com/flowswiss/goclient/macbaremetal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMacBareMetalDevice_PowerState(t *testing.T) {
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "stopped", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("flow_mac_bare_metal_device.foobar", "id"),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "name", deviceName),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "location_id", "2"),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "product_id", "50"),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "power_state", "stopped"),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "reinstall_trigger", "initial"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "running", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "power_state", "running"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "running", "reinstalled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "power_state", "running"),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "reinstall_trigger", "reinstalled"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMacBareMetalDeviceConfigPowerState = `
+data "flow_mac_bare_metal_network" "foobar" {
+	name = "default"
+}
+
+resource "flow_mac_bare_metal_device" "foobar" {
+	name       = "%s"
+	location   = "ZRH1"
+	product    = "Mac mini M1"
+	network_id = data.flow_mac_bare_metal_network.foobar.id
+	password   = "Sup3rS3cr3t!"
+
+	power_state       = "%s"
+	reinstall_trigger = "%s"
+}
+`
+
+// TestAccMacBareMetalDevice_DelayedReinstall makes sure that the reinstallation is waited on even if the device is
+// still running when the workflow has been started, as the device is running before and after the reinstallation.
+func TestAccMacBareMetalDevice_DelayedReinstall(t *testing.T) {
+	if testAccFakeAPI == nil {
+		t.Skip("delaying a workflow requires the fake api")
+	}
+
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "running", "initial"),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.delayDevices(deviceName)
+				},
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "running", "reinstalled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "power_state", "running"),
+					resource.TestCheckResourceAttrWith("flow_mac_bare_metal_device.foobar", "id", func(value string) error {
+						id, err := strconv.Atoi(value)
+						if err != nil {
+							return err
+						}
+
+						if !testAccFakeAPI.settled("device", id) {
+							return fmt.Errorf("expected the reinstallation of device %d to be finished", id)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMacBareMetalDevice_FailedReinstall(t *testing.T) {
+	if testAccFakeAPI == nil {
+		t.Skip("failing a workflow requires the fake api")
+	}
+
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "running", "initial"),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.failDevices(deviceName)
+				},
+				Config:      fmt.Sprintf(testAccMacBareMetalDeviceConfigPowerState, deviceName, "running", "reinstalled"),
+				ExpectError: regexp.MustCompile("failed to reinstall"),
+			},
+		},
+	})
+}
+
+// TestAccMacBareMetalDevice_AddReinstallTrigger makes sure that adding the reinstall trigger to an existing device only
+// arms the trigger. The workflows of the device are made to fail, so an unexpected reinstallation fails the test.
+func TestAccMacBareMetalDevice_AddReinstallTrigger(t *testing.T) {
+	if testAccFakeAPI == nil {
+		t.Skip("detecting a reinstallation requires the fake api")
+	}
+
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigReinstallTrigger, deviceName, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("flow_mac_bare_metal_device.foobar", "reinstall_trigger"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.failDevices(deviceName)
+				},
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigReinstallTrigger, deviceName, `"initial"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "power_state", "running"),
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "reinstall_trigger", "initial"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMacBareMetalDeviceConfigReinstallTrigger = `
+data "flow_mac_bare_metal_network" "foobar" {
+	name = "default"
+}
+
+resource "flow_mac_bare_metal_device" "foobar" {
+	name       = "%s"
+	location   = "ZRH1"
+	product    = "Mac mini M1"
+	network_id = data.flow_mac_bare_metal_network.foobar.id
+	password   = "Sup3rS3cr3t!"
+
+	reinstall_trigger = %s
+}
+`
+
+// TestAccMacBareMetalDevice_ChangePassword makes sure that changing the password recreates the device, as the api can
+// not rotate the password in place.
+func TestAccMacBareMetalDevice_ChangePassword(t *testing.T) {
+	deviceName := acctest.RandomWithPrefix("test-device")
+
+	var deviceID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPassword, deviceName, "Sup3rS3cr3t!"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("flow_mac_bare_metal_device.foobar", "id", func(value string) error {
+						deviceID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMacBareMetalDeviceConfigPassword, deviceName, "N3wS3cr3t!"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flow_mac_bare_metal_device.foobar", "password", "N3wS3cr3t!"),
+					resource.TestCheckResourceAttrWith("flow_mac_bare_metal_device.foobar", "id", func(value string) error {
+						if value == deviceID {
+							return fmt.Errorf("expected device %s to be recreated", deviceID)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccMacBareMetalDeviceConfigPassword = `
+data "flow_mac_bare_metal_network" "foobar" {
+	name = "default"
+}
+
+resource "flow_mac_bare_metal_device" "foobar" {
+	name       = "%s"
+	location   = "ZRH1"
+	product    = "Mac mini M1"
+	network_id = data.flow_mac_bare_metal_network.foobar.id
+	password   = "%s"
+}
+`
+
+func TestMacBareMetalDeviceResource_RunWorkflow(t *testing.T) {
+	api := newFakeAPI(fakeAPIToken)
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	client := goclient.NewClient(goclient.WithToken(fakeAPIToken), goclient.WithBase(server.URL+"/"))
+	devices := macBareMetalDeviceResource{client: client, deviceService: macbaremetal.NewDeviceService(client)}
+
+	device := macbaremetal.Device{ID: api.nextID(), Name: "test-device", Status: fakeDeviceStatus(fakeDeviceStatusRunning)}
+	api.macBareMetalDevices.Put(device.ID, device)
+	api.delayDevices(device.Name)
+
+	// the device is still running after the workflow has been started, which must not end the wait
+	device, diagnostics := devices.runWorkflow(context.Background(), device.ID, "reinstall", macBareMetalDeviceStatusRunning)
+	if diagnostics.HasError() {
+		t.Fatalf("unable to reinstall device: %v", diagnostics)
+	}
+
+	if device.Status.Key != macBareMetalDeviceStatusRunning || !api.settled("device", device.ID) {
+		t.Errorf("expected the reinstallation of device %d to be finished, got status %q", device.ID, device.Status.Key)
+	}
+}
@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	_ tfsdk.ResourceType = (*macBareMetalElasticIPDeviceAttachmentResourceType)(nil)
-	_ tfsdk.Resource     = (*macBareMetalElasticIPDeviceAttachmentResource)(nil)
+	_ tfsdk.ResourceType            = (*macBareMetalElasticIPDeviceAttachmentResourceType)(nil)
+	_ tfsdk.Resource                = (*macBareMetalElasticIPDeviceAttachmentResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*macBareMetalElasticIPDeviceAttachmentResource)(nil)
 )
 
 type macBareMetalElasticIPDeviceAttachmentResourceData struct {
@@ -133,19 +134,35 @@ func (c macBareMetalElasticIPDeviceAttachmentResource) Read(ctx context.Context,
 	}
 
 	device, err := macbaremetal.NewDeviceService(c.client).Get(ctx, int(state.DeviceID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get device: %s", err))
 		return
 	}
 
-	elasticIP, diagnostics := findMacBareMetalElasticIP(ctx, c.elasticIPService, int(state.ElasticIPID.Value))
-	response.Diagnostics.Append(diagnostics...)
-	if response.Diagnostics.HasError() {
+	elasticIP, err := findMacBareMetalElasticIP(ctx, c.elasticIPService, int(state.ElasticIPID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to find elastic ip: %s", err))
 		return
 	}
 
 	state.FromEntity(device, elasticIP)
 
+	// the elastic ip has been detached outside of terraform
+	if state.NetworkInterfaceID.Null {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
 }
@@ -168,3 +185,7 @@ func (c macBareMetalElasticIPDeviceAttachmentResource) Delete(ctx context.Contex
 		return
 	}
 }
+
+func (c macBareMetalElasticIPDeviceAttachmentResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "device_id", "elastic_ip_id")
+}
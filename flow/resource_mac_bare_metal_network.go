@@ -3,10 +3,10 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flowswiss/goclient/macbaremetal"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -17,6 +17,12 @@ var (
 	_ tfsdk.ResourceWithImportState = (*macBareMetalNetworkResource)(nil)
 )
 
+var macBareMetalNetworkTimeouts = resourceTimeouts{
+	Create: 5 * time.Minute,
+	Update: 5 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 type macBareMetalNetworkResourceAllocationPool struct {
 	Start types.String `tfsdk:"start"`
 	End   types.String `tfsdk:"end"`
@@ -31,6 +37,8 @@ type macBareMetalNetworkResourceData struct {
 	DomainNameServers []types.String                             `tfsdk:"domain_name_servers"`
 	AllocationPool    *macBareMetalNetworkResourceAllocationPool `tfsdk:"allocation_pool"`
 	GatewayIP         types.String                               `tfsdk:"gateway_ip"`
+
+	Timeouts *timeoutsResourceData `tfsdk:"timeouts"`
 }
 
 func (r *macBareMetalNetworkResourceData) FromEntity(network macbaremetal.Network) {
@@ -133,6 +141,7 @@ func (r macBareMetalNetworkResourceType) GetSchema(ctx context.Context) (tfsdk.S
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"timeouts": macBareMetalNetworkTimeouts.Attribute(),
 		},
 	}, nil
 }
@@ -160,6 +169,15 @@ func (r macBareMetalNetworkResource) Create(ctx context.Context, request tfsdk.C
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.CreateTimeout(macBareMetalNetworkTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	create := macbaremetal.NetworkCreate{
 		Name:       config.Name.Value,
 		LocationID: int(config.LocationID.Value),
@@ -192,6 +210,7 @@ func (r macBareMetalNetworkResource) Create(ctx context.Context, request tfsdk.C
 
 	var state macBareMetalNetworkResourceData
 	state.FromEntity(network)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -206,6 +225,11 @@ func (r macBareMetalNetworkResource) Read(ctx context.Context, request tfsdk.Rea
 	}
 
 	network, err := r.networkService.Get(ctx, int(state.ID.Value))
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get network: %s", err))
 		return
@@ -232,6 +256,15 @@ func (r macBareMetalNetworkResource) Update(ctx context.Context, request tfsdk.U
 		return
 	}
 
+	timeout, diagnostics := config.Timeouts.UpdateTimeout(macBareMetalNetworkTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	update := macbaremetal.NetworkUpdate{
 		Name:       config.Name.Value,
 		DomainName: config.DomainName.Value,
@@ -251,6 +284,7 @@ func (r macBareMetalNetworkResource) Update(ctx context.Context, request tfsdk.U
 	}
 
 	state.FromEntity(network)
+	state.Timeouts = config.Timeouts
 
 	diagnostics = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diagnostics...)
@@ -264,13 +298,21 @@ func (r macBareMetalNetworkResource) Delete(ctx context.Context, request tfsdk.D
 		return
 	}
 
-	err := r.networkService.Delete(ctx, int(state.ID.Value))
-	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to delete network: %s", err))
+	timeout, diagnostics := state.Timeouts.DeleteTimeout(macBareMetalNetworkTimeouts)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	diagnostics = deleteOnceReleased(ctx, fmt.Sprintf("network %d", state.ID.Value), func(ctx context.Context) error {
+		return r.networkService.Delete(ctx, int(state.ID.Value))
+	})
+	response.Diagnostics.Append(diagnostics...)
 }
 
 func (r macBareMetalNetworkResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateFromID(ctx, request, response, "id")
 }
@@ -16,6 +16,7 @@ import (
 var (
 	_ tfsdk.ResourceType                 = (*macBareMetalSecurityGroupRuleResourceType)(nil)
 	_ tfsdk.Resource                     = (*macBareMetalSecurityGroupRuleResource)(nil)
+	_ tfsdk.ResourceWithImportState      = (*macBareMetalSecurityGroupRuleResource)(nil)
 	_ tfsdk.ResourceWithConfigValidators = (*macBareMetalSecurityGroupRuleResource)(nil)
 )
 
@@ -265,6 +266,11 @@ func (r macBareMetalSecurityGroupRuleResource) Read(ctx context.Context, request
 	ruleID := int(state.ID.Value)
 
 	list, err := r.securityGroupService.Rules(securityGroupID).List(ctx, goclient.Cursor{NoFilter: 1})
+	if isNotFoundError(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list security group rules: %s", err))
 		return
@@ -280,7 +286,7 @@ func (r macBareMetalSecurityGroupRuleResource) Read(ctx context.Context, request
 		}
 	}
 
-	response.Diagnostics.AddError("Not Found", fmt.Sprintf("security group rule %d could not be found", ruleID))
+	response.State.RemoveResource(ctx)
 }
 
 func (r macBareMetalSecurityGroupRuleResource) Update(ctx context.Context, request tfsdk.UpdateResourceRequest, response *tfsdk.UpdateResourceResponse) {
@@ -352,3 +358,7 @@ func (r macBareMetalSecurityGroupRuleResource) ConfigValidators(ctx context.Cont
 		validators.MutuallyExclusive("port_range", "icmp"),
 	}
 }
+
+func (r macBareMetalSecurityGroupRuleResource) ImportState(ctx context.Context, request tfsdk.ImportResourceStateRequest, response *tfsdk.ImportResourceStateResponse) {
+	importStateFromID(ctx, request, response, "security_group_id", "id")
+}
@@ -33,6 +33,12 @@ func TestAccMacBareMetalSecurityGroupRule_Basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr("flow_mac_bare_metal_security_group_rule.foobar", "icmp"),
 				),
 			},
+			{
+				ResourceName:      "flow_mac_bare_metal_security_group_rule.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateID("flow_mac_bare_metal_security_group_rule.foobar", "security_group_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
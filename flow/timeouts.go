@@ -0,0 +1,91 @@
+package flow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceTimeouts holds the default timeouts of the long-running operations of a resource.
+type resourceTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+func (r resourceTimeouts) Attribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"create": {
+				Type:                types.StringType,
+				MarkdownDescription: fmt.Sprintf("timeout duration for creating the resource (defaults to %s)", r.Create),
+				Optional:            true,
+			},
+			"update": {
+				Type:                types.StringType,
+				MarkdownDescription: fmt.Sprintf("timeout duration for updating the resource (defaults to %s)", r.Update),
+				Optional:            true,
+			},
+			"delete": {
+				Type:                types.StringType,
+				MarkdownDescription: fmt.Sprintf("timeout duration for deleting the resource (defaults to %s)", r.Delete),
+				Optional:            true,
+			},
+		}),
+		MarkdownDescription: "timeouts of the long-running operations of the resource",
+		Optional:            true,
+	}
+}
+
+type timeoutsResourceData struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+func (t *timeoutsResourceData) CreateTimeout(defaults resourceTimeouts) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return defaults.Create, nil
+	}
+
+	return parseTimeout(path.Root("timeouts").AtName("create"), t.Create, defaults.Create)
+}
+
+func (t *timeoutsResourceData) UpdateTimeout(defaults resourceTimeouts) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return defaults.Update, nil
+	}
+
+	return parseTimeout(path.Root("timeouts").AtName("update"), t.Update, defaults.Update)
+}
+
+func (t *timeoutsResourceData) DeleteTimeout(defaults resourceTimeouts) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return defaults.Delete, nil
+	}
+
+	return parseTimeout(path.Root("timeouts").AtName("delete"), t.Delete, defaults.Delete)
+}
+
+func parseTimeout(attributePath path.Path, value types.String, fallback time.Duration) (timeout time.Duration, diagnostics diag.Diagnostics) {
+	if value.Null || value.Unknown {
+		return fallback, nil
+	}
+
+	timeout, err := time.ParseDuration(value.Value)
+	if err != nil {
+		diagnostics.AddAttributeError(attributePath, "Invalid Timeout", fmt.Sprintf("unable to parse timeout: %s", err))
+		return
+	}
+
+	if timeout <= 0 {
+		diagnostics.AddAttributeError(attributePath, "Invalid Timeout", "timeout must be a positive duration")
+		return
+	}
+
+	return
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.AttributeValidator = (*jsonObjectValidator)(nil)
+
+type jsonObjectValidator struct{}
+
+func JSONObject() tfsdk.AttributeValidator {
+	return jsonObjectValidator{}
+}
+
+func (j jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON encoded object"
+}
+
+func (j jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return j.Description(ctx)
+}
+
+func (j jsonObjectValidator) Validate(ctx context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	var value types.String
+
+	diagnostics := tfsdk.ValueAs(ctx, request.AttributeConfig, &value)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if value.Unknown || value.Null {
+		return
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(value.Value), &object); err != nil || object == nil {
+		response.Diagnostics.AddAttributeError(
+			request.AttributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("The attribute %s must be a JSON encoded object, got %q.", request.AttributePath.String(), value.Value),
+		)
+	}
+}
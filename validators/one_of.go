@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.AttributeValidator = (*oneOfValidator)(nil)
+
+type oneOfValidator struct {
+	values []string
+}
+
+func OneOf(values ...string) tfsdk.AttributeValidator {
+	return oneOfValidator{values: values}
+}
+
+func (o oneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %s", strings.Join(o.values, ", "))
+}
+
+func (o oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return o.Description(ctx)
+}
+
+func (o oneOfValidator) Validate(ctx context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	var value types.String
+
+	diagnostics := tfsdk.ValueAs(ctx, request.AttributeConfig, &value)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if value.Unknown || value.Null {
+		return
+	}
+
+	for _, allowed := range o.values {
+		if value.Value == allowed {
+			return
+		}
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.AttributePath,
+		"Invalid Attribute Value",
+		fmt.Sprintf("The attribute %s must be one of %s, got %q.", request.AttributePath.String(), strings.Join(o.values, ", "), value.Value),
+	)
+}
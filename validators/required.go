@@ -0,0 +1,64 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.ResourceConfigValidator = (*atLeastOneOfValidator)(nil)
+
+type atLeastOneOfValidator struct {
+	attributes []path.Path
+}
+
+func AtLeastOneOf(attributes ...string) tfsdk.ResourceConfigValidator {
+	attributePaths := make([]path.Path, len(attributes))
+	for i, attribute := range attributes {
+		attributePaths[i] = path.Root(attribute)
+	}
+
+	return atLeastOneOfValidator{attributes: attributePaths}
+}
+
+func (a atLeastOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("at least one of the attributes %s must be configured", a.attributeList())
+}
+
+func (a atLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return a.Description(ctx)
+}
+
+func (a atLeastOneOfValidator) ValidateResource(ctx context.Context, request tfsdk.ValidateResourceConfigRequest, response *tfsdk.ValidateResourceConfigResponse) {
+	for _, attribute := range a.attributes {
+		var value attr.Value
+
+		diagnostics := request.Config.GetAttribute(ctx, attribute, &value)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !value.IsNull() {
+			return
+		}
+	}
+
+	response.Diagnostics.AddError(
+		"Missing Attribute Error",
+		fmt.Sprintf("The resource requires one of the attributes %s. Please configure one of them.", a.attributeList()),
+	)
+}
+
+func (a atLeastOneOfValidator) attributeList() string {
+	attributeStrings := make([]string, len(a.attributes))
+	for i, attribute := range a.attributes {
+		attributeStrings[i] = attribute.String()
+	}
+
+	return strings.Join(attributeStrings, ", ")
+}